@@ -0,0 +1,8 @@
+select
+  backend_group_id,
+  name,
+  type,
+  target_group_ids
+from
+  yandexcloud_alb_backend_group
+limit 2;
\ No newline at end of file
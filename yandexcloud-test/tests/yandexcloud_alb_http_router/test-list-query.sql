@@ -0,0 +1,7 @@
+select
+  http_router_id,
+  name,
+  virtual_hosts
+from
+  yandexcloud_alb_http_router
+limit 2;
\ No newline at end of file
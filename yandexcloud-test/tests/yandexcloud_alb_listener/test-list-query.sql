@@ -0,0 +1,9 @@
+select
+  load_balancer_id,
+  name,
+  type,
+  ports,
+  http_to_https_redirect
+from
+  yandexcloud_alb_listener
+limit 2;
\ No newline at end of file
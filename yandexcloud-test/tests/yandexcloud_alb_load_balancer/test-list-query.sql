@@ -0,0 +1,9 @@
+select
+  load_balancer_id,
+  name,
+  status,
+  network_id,
+  security_group_ids
+from
+  yandexcloud_alb_load_balancer
+limit 2;
\ No newline at end of file
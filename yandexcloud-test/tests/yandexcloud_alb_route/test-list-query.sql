@@ -0,0 +1,9 @@
+select
+  http_router_id,
+  virtual_host_name,
+  name,
+  action,
+  backend_group_id
+from
+  yandexcloud_alb_route
+limit 2;
\ No newline at end of file
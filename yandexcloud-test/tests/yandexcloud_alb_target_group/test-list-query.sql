@@ -0,0 +1,7 @@
+select
+  target_group_id,
+  name,
+  target_ips
+from
+  yandexcloud_alb_target_group
+limit 2;
\ No newline at end of file
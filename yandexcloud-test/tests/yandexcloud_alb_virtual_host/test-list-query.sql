@@ -0,0 +1,8 @@
+select
+  http_router_id,
+  name,
+  authority,
+  route_count
+from
+  yandexcloud_alb_virtual_host
+limit 2;
\ No newline at end of file
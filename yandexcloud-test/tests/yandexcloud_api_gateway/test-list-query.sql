@@ -0,0 +1,8 @@
+select
+  api_gateway_id,
+  name,
+  domain,
+  status
+from
+  yandexcloud_api_gateway
+limit 2;
\ No newline at end of file
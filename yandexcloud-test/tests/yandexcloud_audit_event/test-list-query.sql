@@ -0,0 +1,11 @@
+select
+  e.event_time,
+  e.event_type,
+  e.subject_name,
+  e.resource_id
+from
+  yandexcloud_audittrails_trail t
+  join yandexcloud_audit_event e on e.trail_id = t.trail_id
+where
+  t.destination_type = 'OBJECT_STORAGE'
+limit 2;
\ No newline at end of file
@@ -0,0 +1,8 @@
+select
+  trail_id,
+  name,
+  status,
+  destination_type
+from
+  yandexcloud_audittrails_trail
+limit 2;
\ No newline at end of file
@@ -0,0 +1,9 @@
+select
+  certificate_id,
+  name,
+  type,
+  status,
+  not_after
+from
+  yandexcloud_cm_certificate
+limit 2;
\ No newline at end of file
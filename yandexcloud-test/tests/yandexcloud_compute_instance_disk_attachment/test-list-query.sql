@@ -0,0 +1,8 @@
+select
+  instance_id,
+  disk_id,
+  device_name,
+  is_boot
+from
+  yandexcloud_compute_instance_disk_attachment
+limit 2;
\ No newline at end of file
@@ -0,0 +1,7 @@
+select
+  instance_group_id,
+  name,
+  folder_id
+from
+  yandexcloud_compute_instance_group
+limit 2;
\ No newline at end of file
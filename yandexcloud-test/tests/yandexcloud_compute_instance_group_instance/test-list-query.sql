@@ -0,0 +1,8 @@
+select
+  managed_instance_id,
+  instance_group_id,
+  instance_id,
+  status
+from
+  yandexcloud_compute_instance_group_instance
+limit 2;
\ No newline at end of file
@@ -0,0 +1,9 @@
+select
+  instance_id,
+  index,
+  subnet_id,
+  primary_v4_address,
+  nat_address
+from
+  yandexcloud_compute_instance_network_interface
+limit 2;
\ No newline at end of file
@@ -0,0 +1,8 @@
+select
+  image_id,
+  repository_name,
+  digest,
+  tags
+from
+  yandexcloud_cr_image
+limit 2;
\ No newline at end of file
@@ -0,0 +1,8 @@
+select
+  lifecycle_policy_id,
+  name,
+  repository_id,
+  status
+from
+  yandexcloud_cr_lifecycle_policy
+limit 2;
\ No newline at end of file
@@ -0,0 +1,7 @@
+select
+  registry_id,
+  name,
+  status
+from
+  yandexcloud_cr_registry
+limit 2;
\ No newline at end of file
@@ -0,0 +1,7 @@
+select
+  repository_id,
+  name,
+  registry_id
+from
+  yandexcloud_cr_repository
+limit 2;
\ No newline at end of file
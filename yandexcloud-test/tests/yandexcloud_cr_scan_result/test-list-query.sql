@@ -0,0 +1,9 @@
+select
+  image_id,
+  repository_name,
+  status,
+  critical,
+  high
+from
+  yandexcloud_cr_scan_result
+limit 2;
\ No newline at end of file
@@ -0,0 +1,8 @@
+select
+  image_id,
+  severity,
+  name,
+  package_name
+from
+  yandexcloud_cr_vulnerability
+limit 2;
\ No newline at end of file
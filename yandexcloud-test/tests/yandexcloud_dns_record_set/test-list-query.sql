@@ -0,0 +1,9 @@
+select
+  dns_zone_id,
+  name,
+  type,
+  ttl,
+  data
+from
+  yandexcloud_dns_record_set
+limit 2;
\ No newline at end of file
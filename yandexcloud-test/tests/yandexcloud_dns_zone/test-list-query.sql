@@ -0,0 +1,8 @@
+select
+  dns_zone_id,
+  name,
+  zone,
+  visibility
+from
+  yandexcloud_dns_zone
+limit 2;
\ No newline at end of file
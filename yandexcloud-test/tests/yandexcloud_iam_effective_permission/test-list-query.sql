@@ -0,0 +1,10 @@
+select
+  subject_id,
+  subject_type,
+  resource_type,
+  resource_id,
+  role_id,
+  granted_at_level
+from
+  yandexcloud_iam_effective_permission
+limit 2;
\ No newline at end of file
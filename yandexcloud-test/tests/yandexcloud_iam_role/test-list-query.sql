@@ -0,0 +1,8 @@
+select
+  role_id,
+  description,
+  service,
+  is_primitive
+from
+  yandexcloud_iam_role
+limit 2;
\ No newline at end of file
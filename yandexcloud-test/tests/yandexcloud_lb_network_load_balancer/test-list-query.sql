@@ -0,0 +1,9 @@
+select
+  network_load_balancer_id,
+  name,
+  type,
+  status,
+  listener_addresses
+from
+  yandexcloud_lb_network_load_balancer
+limit 2;
\ No newline at end of file
@@ -0,0 +1,8 @@
+select
+  target_group_id,
+  name,
+  region_id,
+  targets
+from
+  yandexcloud_lb_target_group
+limit 2;
\ No newline at end of file
@@ -0,0 +1,8 @@
+select
+  network_load_balancer_id,
+  target_group_id,
+  address,
+  status
+from
+  yandexcloud_lb_target_state
+limit 2;
\ No newline at end of file
@@ -0,0 +1,9 @@
+select
+  secret_id,
+  name,
+  status,
+  kms_key_id,
+  current_version_id
+from
+  yandexcloud_lockbox_secret
+limit 2;
\ No newline at end of file
@@ -0,0 +1,9 @@
+select
+  secret_id,
+  version_id,
+  status,
+  is_current,
+  payload_entry_keys
+from
+  yandexcloud_lockbox_secret_version
+limit 2;
\ No newline at end of file
@@ -0,0 +1,10 @@
+select
+  e.timestamp,
+  e.level,
+  e.message
+from
+  yandexcloud_logging_group g
+  join yandexcloud_logging_entry e on e.log_group_id = g.log_group_id
+where
+  e.timestamp > now() - interval '1 hour'
+limit 2;
\ No newline at end of file
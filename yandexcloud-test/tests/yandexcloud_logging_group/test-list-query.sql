@@ -0,0 +1,8 @@
+select
+  log_group_id,
+  name,
+  status,
+  retention_period
+from
+  yandexcloud_logging_group
+limit 2;
\ No newline at end of file
@@ -0,0 +1,8 @@
+select
+  name,
+  engine,
+  cluster_id,
+  zone_id
+from
+  yandexcloud_mdb_host
+limit 2;
\ No newline at end of file
@@ -0,0 +1,8 @@
+select
+  cluster_id,
+  name,
+  version,
+  brokers_count
+from
+  yandexcloud_mdb_kafka_cluster
+limit 2;
\ No newline at end of file
@@ -0,0 +1,8 @@
+select
+  name,
+  cluster_id,
+  partitions,
+  retention_ms
+from
+  yandexcloud_mdb_kafka_topic
+limit 2;
\ No newline at end of file
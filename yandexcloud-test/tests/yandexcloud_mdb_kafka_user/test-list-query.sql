@@ -0,0 +1,8 @@
+select
+  name,
+  cluster_id,
+  wildcard_produce,
+  wildcard_consume
+from
+  yandexcloud_mdb_kafka_user
+limit 2;
\ No newline at end of file
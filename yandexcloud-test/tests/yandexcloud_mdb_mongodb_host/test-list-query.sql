@@ -0,0 +1,8 @@
+select
+  name,
+  cluster_id,
+  zone_id,
+  role
+from
+  yandexcloud_mdb_mongodb_host
+limit 2;
\ No newline at end of file
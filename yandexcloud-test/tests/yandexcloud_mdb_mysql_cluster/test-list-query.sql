@@ -0,0 +1,8 @@
+select
+  cluster_id,
+  name,
+  environment,
+  version
+from
+  yandexcloud_mdb_mysql_cluster
+limit 2;
\ No newline at end of file
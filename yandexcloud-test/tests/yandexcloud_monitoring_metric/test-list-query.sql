@@ -0,0 +1,10 @@
+select
+  name,
+  resource_id,
+  timestamp,
+  value
+from
+  yandexcloud_monitoring_metric
+where
+  query = '"cpu_utilization"{service="compute"}'
+limit 2;
\ No newline at end of file
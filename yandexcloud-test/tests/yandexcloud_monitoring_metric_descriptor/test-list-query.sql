@@ -0,0 +1,8 @@
+select
+  name,
+  type,
+  service,
+  labels
+from
+  yandexcloud_monitoring_metric_descriptor
+limit 2;
\ No newline at end of file
@@ -0,0 +1,10 @@
+select
+  instance_id,
+  ip,
+  protocol,
+  from_port,
+  to_port,
+  rule_id
+from
+  yandexcloud_network_exposure
+limit 2;
\ No newline at end of file
@@ -0,0 +1,7 @@
+select
+  organization_id,
+  name,
+  title
+from
+  yandexcloud_organization
+limit 2;
\ No newline at end of file
@@ -0,0 +1,8 @@
+select
+  organization_id,
+  role_id,
+  subject_id,
+  subject_type
+from
+  yandexcloud_organization_access_binding
+limit 2;
\ No newline at end of file
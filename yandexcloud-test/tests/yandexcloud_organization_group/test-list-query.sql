@@ -0,0 +1,6 @@
+select
+  group_id,
+  name
+from
+  yandexcloud_organization_group
+limit 2;
\ No newline at end of file
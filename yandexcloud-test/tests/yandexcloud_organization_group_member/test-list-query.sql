@@ -0,0 +1,7 @@
+select
+  group_name,
+  subject_id,
+  subject_type
+from
+  yandexcloud_organization_group_member
+limit 2;
\ No newline at end of file
@@ -0,0 +1,8 @@
+select
+  federation_id,
+  name,
+  issuer,
+  sso_url
+from
+  yandexcloud_organization_saml_federation
+limit 2;
\ No newline at end of file
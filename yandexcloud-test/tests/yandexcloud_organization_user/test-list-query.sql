@@ -0,0 +1,8 @@
+select
+  user_id,
+  type,
+  preferred_username,
+  federation_name
+from
+  yandexcloud_organization_user
+limit 2;
\ No newline at end of file
@@ -0,0 +1,8 @@
+select
+  container_id,
+  name,
+  revision_id,
+  image_url
+from
+  yandexcloud_serverless_container
+limit 2;
\ No newline at end of file
@@ -0,0 +1,7 @@
+select
+  function_id,
+  name,
+  status
+from
+  yandexcloud_serverless_function
+limit 2;
\ No newline at end of file
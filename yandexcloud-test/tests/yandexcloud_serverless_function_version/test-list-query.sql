@@ -0,0 +1,8 @@
+select
+  version_id,
+  function_id,
+  runtime,
+  runtime_deprecated
+from
+  yandexcloud_serverless_function_version
+limit 2;
\ No newline at end of file
@@ -0,0 +1,8 @@
+select
+  trigger_id,
+  name,
+  rule_type,
+  target_type
+from
+  yandexcloud_serverless_trigger
+limit 2;
\ No newline at end of file
@@ -0,0 +1,9 @@
+select
+  private_endpoint_id,
+  name,
+  network_id,
+  status,
+  address
+from
+  yandexcloud_vpc_private_endpoint
+limit 2;
\ No newline at end of file
@@ -0,0 +1,9 @@
+select
+  route_table_id,
+  destination_prefix,
+  next_hop_type,
+  next_hop_address,
+  gateway_id
+from
+  yandexcloud_vpc_route_table_route
+limit 2;
\ No newline at end of file
@@ -0,0 +1,11 @@
+select
+  security_group_id,
+  rule_id,
+  direction,
+  protocol,
+  from_port,
+  to_port,
+  cidr
+from
+  yandexcloud_vpc_security_group_rule
+limit 2;
\ No newline at end of file
@@ -0,0 +1,9 @@
+select
+  subnet_id,
+  address,
+  ip_version,
+  referrer_type,
+  referrer_id
+from
+  yandexcloud_vpc_subnet_used_address
+limit 2;
\ No newline at end of file
@@ -0,0 +1,10 @@
+select
+  database_id,
+  name,
+  type,
+  status,
+  endpoint,
+  location_id
+from
+  yandexcloud_ydb_database
+limit 2;
\ No newline at end of file
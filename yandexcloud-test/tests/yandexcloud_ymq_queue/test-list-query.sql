@@ -0,0 +1,8 @@
+select
+  name,
+  queue_url,
+  visibility_timeout,
+  approximate_number_of_messages
+from
+  yandexcloud_ymq_queue
+limit 2;
\ No newline at end of file
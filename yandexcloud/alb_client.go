@@ -0,0 +1,321 @@
+package yandexcloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// --- ALB Load Balancer types ---
+type ALBLoadBalancer struct {
+	Id               string                 `json:"id"`
+	FolderId         string                 `json:"folderId"`
+	CreatedAt        string                 `json:"createdAt"`
+	Name             string                 `json:"name"`
+	Description      string                 `json:"description"`
+	Labels           map[string]string      `json:"labels"`
+	Status           string                 `json:"status"`
+	RegionId         string                 `json:"regionId"`
+	NetworkId        string                 `json:"networkId"`
+	Listeners        []ALBListener          `json:"listeners"`
+	AllocationPolicy *ALBAllocationPolicy   `json:"allocationPolicy,omitempty"`
+	LogGroupId       string                 `json:"logGroupId"`
+	SecurityGroupIds []string               `json:"securityGroupIds"`
+	AutoScalePolicy  map[string]interface{} `json:"autoScalePolicy,omitempty"`
+	LogOptions       map[string]interface{} `json:"logOptions,omitempty"`
+}
+
+type ALBAllocationPolicy struct {
+	Locations []ALBLocation `json:"locations"`
+}
+
+type ALBLocation struct {
+	ZoneId         string `json:"zoneId"`
+	SubnetId       string `json:"subnetId"`
+	DisableTraffic bool   `json:"disableTraffic"`
+}
+
+// ALBListener is a listener of an application load balancer. Exactly one of Http, Tls and Stream is set.
+type ALBListener struct {
+	Name      string             `json:"name"`
+	Endpoints []ALBEndpoint      `json:"endpoints"`
+	Http      *ALBHttpListener   `json:"http,omitempty"`
+	Tls       *ALBTlsListener    `json:"tls,omitempty"`
+	Stream    *ALBStreamListener `json:"stream,omitempty"`
+}
+
+type ALBEndpoint struct {
+	Addresses []ALBAddress `json:"addresses"`
+	// Ports are int64 values, which the API encodes as JSON strings.
+	Ports []json.Number `json:"ports"`
+}
+
+// ALBAddress is a listener address. Exactly one of the fields is set.
+type ALBAddress struct {
+	ExternalIpv4Address *ALBAddressValue `json:"externalIpv4Address,omitempty"`
+	InternalIpv4Address *ALBAddressValue `json:"internalIpv4Address,omitempty"`
+	ExternalIpv6Address *ALBAddressValue `json:"externalIpv6Address,omitempty"`
+}
+
+type ALBAddressValue struct {
+	Address  string `json:"address"`
+	SubnetId string `json:"subnetId,omitempty"`
+}
+
+type ALBHttpListener struct {
+	Handler   *ALBHttpHandler `json:"handler,omitempty"`
+	Redirects *ALBRedirects   `json:"redirects,omitempty"`
+}
+
+type ALBRedirects struct {
+	HttpToHttps bool `json:"httpToHttps"`
+}
+
+type ALBHttpHandler struct {
+	HttpRouterId string                 `json:"httpRouterId"`
+	Http2Options map[string]interface{} `json:"http2Options,omitempty"`
+	AllowHttp10  bool                   `json:"allowHttp10"`
+}
+
+type ALBStreamHandler struct {
+	BackendGroupId string `json:"backendGroupId"`
+}
+
+type ALBStreamListener struct {
+	Handler *ALBStreamHandler `json:"handler,omitempty"`
+}
+
+type ALBTlsListener struct {
+	DefaultHandler *ALBTlsHandler  `json:"defaultHandler,omitempty"`
+	SniHandlers    []ALBSniHandler `json:"sniHandlers"`
+}
+
+type ALBTlsHandler struct {
+	HttpHandler    *ALBHttpHandler   `json:"httpHandler,omitempty"`
+	StreamHandler  *ALBStreamHandler `json:"streamHandler,omitempty"`
+	CertificateIds []string          `json:"certificateIds"`
+}
+
+type ALBSniHandler struct {
+	Name        string        `json:"name"`
+	ServerNames []string      `json:"serverNames"`
+	Handler     ALBTlsHandler `json:"handler"`
+}
+
+type ListALBLoadBalancersResponse struct {
+	LoadBalancers []*ALBLoadBalancer `json:"loadBalancers"`
+	NextPageToken string             `json:"nextPageToken"`
+}
+
+// --- ALB HTTP Router types ---
+type ALBHttpRouter struct {
+	Id           string                 `json:"id"`
+	FolderId     string                 `json:"folderId"`
+	CreatedAt    string                 `json:"createdAt"`
+	Name         string                 `json:"name"`
+	Description  string                 `json:"description"`
+	Labels       map[string]string      `json:"labels"`
+	VirtualHosts []ALBVirtualHost       `json:"virtualHosts"`
+	RouteOptions map[string]interface{} `json:"routeOptions,omitempty"`
+}
+
+type ALBVirtualHost struct {
+	Name                  string                   `json:"name"`
+	Authority             []string                 `json:"authority"`
+	Routes                []ALBRoute               `json:"routes"`
+	ModifyRequestHeaders  []map[string]interface{} `json:"modifyRequestHeaders"`
+	ModifyResponseHeaders []map[string]interface{} `json:"modifyResponseHeaders"`
+	RouteOptions          map[string]interface{}   `json:"routeOptions,omitempty"`
+}
+
+// ALBRoute is a route of a virtual host. Exactly one of Http and Grpc is set.
+type ALBRoute struct {
+	Name         string                 `json:"name"`
+	Http         *ALBHttpRoute          `json:"http,omitempty"`
+	Grpc         *ALBGrpcRoute          `json:"grpc,omitempty"`
+	RouteOptions map[string]interface{} `json:"routeOptions,omitempty"`
+}
+
+type ALBHttpRoute struct {
+	Match          *ALBHttpRouteMatch     `json:"match,omitempty"`
+	Route          *ALBRouteAction        `json:"route,omitempty"`
+	Redirect       map[string]interface{} `json:"redirect,omitempty"`
+	DirectResponse map[string]interface{} `json:"directResponse,omitempty"`
+}
+
+type ALBHttpRouteMatch struct {
+	HttpMethod []string        `json:"httpMethod"`
+	Path       *ALBStringMatch `json:"path,omitempty"`
+}
+
+type ALBGrpcRoute struct {
+	Match          *ALBGrpcRouteMatch     `json:"match,omitempty"`
+	Route          *ALBRouteAction        `json:"route,omitempty"`
+	StatusResponse map[string]interface{} `json:"statusResponse,omitempty"`
+}
+
+type ALBGrpcRouteMatch struct {
+	Fqmn *ALBStringMatch `json:"fqmn,omitempty"`
+}
+
+// ALBStringMatch matches a string. Exactly one of the fields is set.
+type ALBStringMatch struct {
+	ExactMatch  string `json:"exactMatch,omitempty"`
+	PrefixMatch string `json:"prefixMatch,omitempty"`
+	RegexMatch  string `json:"regexMatch,omitempty"`
+}
+
+type ALBRouteAction struct {
+	BackendGroupId string `json:"backendGroupId"`
+	Timeout        string `json:"timeout"`
+	IdleTimeout    string `json:"idleTimeout"`
+	HostRewrite    string `json:"hostRewrite,omitempty"`
+	PrefixRewrite  string `json:"prefixRewrite,omitempty"`
+}
+
+type ListALBHttpRoutersResponse struct {
+	HttpRouters   []*ALBHttpRouter `json:"httpRouters"`
+	NextPageToken string           `json:"nextPageToken"`
+}
+
+// --- ALB Backend Group types ---
+type ALBBackendGroup struct {
+	Id          string            `json:"id"`
+	FolderId    string            `json:"folderId"`
+	CreatedAt   string            `json:"createdAt"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Labels      map[string]string `json:"labels"`
+	Http        *ALBBackendList   `json:"http,omitempty"`
+	Grpc        *ALBBackendList   `json:"grpc,omitempty"`
+	Stream      *ALBBackendList   `json:"stream,omitempty"`
+}
+
+// ALBBackendList holds the backends of one backend group type together with its session affinity settings.
+type ALBBackendList struct {
+	Backends   []ALBBackend           `json:"backends"`
+	Connection map[string]interface{} `json:"connection,omitempty"`
+	Header     map[string]interface{} `json:"header,omitempty"`
+	Cookie     map[string]interface{} `json:"cookie,omitempty"`
+}
+
+type ALBBackend struct {
+	Name                string                   `json:"name"`
+	BackendWeight       json.Number              `json:"backendWeight,omitempty"`
+	Port                int64                    `json:"port,string"`
+	TargetGroups        *ALBBackendTargetGroups  `json:"targetGroups,omitempty"`
+	StorageBucket       *ALBBackendStorageBucket `json:"storageBucket,omitempty"`
+	Healthchecks        []map[string]interface{} `json:"healthchecks"`
+	Tls                 map[string]interface{}   `json:"tls,omitempty"`
+	UseHttp2            bool                     `json:"useHttp2"`
+	LoadBalancingConfig map[string]interface{}   `json:"loadBalancingConfig,omitempty"`
+}
+
+type ALBBackendTargetGroups struct {
+	TargetGroupIds []string `json:"targetGroupIds"`
+}
+
+type ALBBackendStorageBucket struct {
+	Bucket string `json:"bucket"`
+}
+
+type ListALBBackendGroupsResponse struct {
+	BackendGroups []*ALBBackendGroup `json:"backendGroups"`
+	NextPageToken string             `json:"nextPageToken"`
+}
+
+// --- ALB Target Group types ---
+type ALBTargetGroup struct {
+	Id          string            `json:"id"`
+	FolderId    string            `json:"folderId"`
+	CreatedAt   string            `json:"createdAt"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Labels      map[string]string `json:"labels"`
+	Targets     []ALBTarget       `json:"targets"`
+}
+
+type ALBTarget struct {
+	IpAddress          string `json:"ipAddress"`
+	SubnetId           string `json:"subnetId"`
+	PrivateIpv4Address bool   `json:"privateIpv4Address"`
+}
+
+type ListALBTargetGroupsResponse struct {
+	TargetGroups  []*ALBTargetGroup `json:"targetGroups"`
+	NextPageToken string            `json:"nextPageToken"`
+}
+
+type ALBClient interface {
+	ListALBLoadBalancers(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*ALBLoadBalancer, string, error)
+	ListALBHttpRouters(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*ALBHttpRouter, string, error)
+	ListALBBackendGroups(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*ALBBackendGroup, string, error)
+	ListALBTargetGroups(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*ALBTargetGroup, string, error)
+}
+
+type yandexALBClient struct {
+	token  string
+	http   *http.Client
+	config *Config
+}
+
+func NewALBClient(token string, timeoutSec int64, config *Config) ALBClient {
+	return &yandexALBClient{
+		token:  token,
+		http:   GetHTTPClient(timeoutSec),
+		config: config,
+	}
+}
+
+func (c *yandexALBClient) apiGet(ctx context.Context, urlStr string, out interface{}) error {
+	return restGet(ctx, c.http, c.config, c.token, "ALB", urlStr, out)
+}
+
+// albListURL builds the URL of a folder-scoped ALB list method, e.g. /loadBalancers.
+func albListURL(resource string, folderID string, pageToken string, pageSize int64) string {
+	endpoint := fmt.Sprintf("https://alb.api.cloud.yandex.net/apploadbalancer/v1/%s", resource)
+	params := url.Values{}
+	params.Set("folderId", folderID)
+	if pageToken != "" {
+		params.Set("pageToken", pageToken)
+	}
+	if pageSize > 0 {
+		params.Set("pageSize", strconv.FormatInt(pageSize, 10))
+	}
+	return fmt.Sprintf("%s?%s", endpoint, params.Encode())
+}
+
+func (c *yandexALBClient) ListALBLoadBalancers(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*ALBLoadBalancer, string, error) {
+	var respBody ListALBLoadBalancersResponse
+	if err := c.apiGet(ctx, albListURL("loadBalancers", folderID, pageToken, pageSize), &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.LoadBalancers, respBody.NextPageToken, nil
+}
+
+func (c *yandexALBClient) ListALBHttpRouters(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*ALBHttpRouter, string, error) {
+	var respBody ListALBHttpRoutersResponse
+	if err := c.apiGet(ctx, albListURL("httpRouters", folderID, pageToken, pageSize), &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.HttpRouters, respBody.NextPageToken, nil
+}
+
+func (c *yandexALBClient) ListALBBackendGroups(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*ALBBackendGroup, string, error) {
+	var respBody ListALBBackendGroupsResponse
+	if err := c.apiGet(ctx, albListURL("backendGroups", folderID, pageToken, pageSize), &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.BackendGroups, respBody.NextPageToken, nil
+}
+
+func (c *yandexALBClient) ListALBTargetGroups(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*ALBTargetGroup, string, error) {
+	var respBody ListALBTargetGroupsResponse
+	if err := c.apiGet(ctx, albListURL("targetGroups", folderID, pageToken, pageSize), &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.TargetGroups, respBody.NextPageToken, nil
+}
@@ -0,0 +1,97 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// --- Audit Trails types ---
+type AuditTrail struct {
+	Id                 string                 `json:"id"`
+	FolderId           string                 `json:"folderId"`
+	CloudId            string                 `json:"cloudId"`
+	CreatedAt          string                 `json:"createdAt"`
+	UpdatedAt          string                 `json:"updatedAt"`
+	Name               string                 `json:"name"`
+	Description        string                 `json:"description"`
+	Labels             map[string]string      `json:"labels"`
+	Destination        AuditTrailDestination  `json:"destination"`
+	ServiceAccountId   string                 `json:"serviceAccountId"`
+	Status             string                 `json:"status"`
+	StatusErrorMessage string                 `json:"statusErrorMessage"`
+	Filter             map[string]interface{} `json:"filter,omitempty"`
+	FilteringPolicy    map[string]interface{} `json:"filteringPolicy,omitempty"`
+}
+
+// AuditTrailDestination is where a trail delivers events; exactly one destination is set.
+type AuditTrailDestination struct {
+	ObjectStorage *struct {
+		BucketId     string `json:"bucketId"`
+		ObjectPrefix string `json:"objectPrefix"`
+	} `json:"objectStorage,omitempty"`
+	CloudLogging *struct {
+		LogGroupId string `json:"logGroupId"`
+	} `json:"cloudLogging,omitempty"`
+	DataStream *struct {
+		DatabaseId string `json:"databaseId"`
+		StreamName string `json:"streamName"`
+	} `json:"dataStream,omitempty"`
+}
+
+type ListAuditTrailsResponse struct {
+	Trails        []*AuditTrail `json:"trails"`
+	NextPageToken string        `json:"nextPageToken"`
+}
+
+type AuditTrailsClient interface {
+	ListAuditTrails(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*AuditTrail, string, error)
+	GetAuditTrail(ctx context.Context, trailID string) (*AuditTrail, error)
+}
+
+type yandexAuditTrailsClient struct {
+	token  string
+	http   *http.Client
+	config *Config
+}
+
+func NewAuditTrailsClient(token string, timeoutSec int64, config *Config) AuditTrailsClient {
+	return &yandexAuditTrailsClient{
+		token:  token,
+		http:   GetHTTPClient(timeoutSec),
+		config: config,
+	}
+}
+
+func (c *yandexAuditTrailsClient) apiGet(ctx context.Context, urlStr string, out interface{}) error {
+	return restGet(ctx, c.http, c.config, c.token, "AuditTrails", urlStr, out)
+}
+
+func (c *yandexAuditTrailsClient) ListAuditTrails(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*AuditTrail, string, error) {
+	const endpoint = "https://audittrails.api.cloud.yandex.net/audit-trails/v1/trails"
+	params := url.Values{}
+	params.Set("folderId", folderID)
+	if pageToken != "" {
+		params.Set("pageToken", pageToken)
+	}
+	if pageSize > 0 {
+		params.Set("pageSize", strconv.FormatInt(pageSize, 10))
+	}
+	var respBody ListAuditTrailsResponse
+	urlStr := fmt.Sprintf("%s?%s", endpoint, params.Encode())
+	if err := c.apiGet(ctx, urlStr, &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.Trails, respBody.NextPageToken, nil
+}
+
+func (c *yandexAuditTrailsClient) GetAuditTrail(ctx context.Context, trailID string) (*AuditTrail, error) {
+	urlStr := fmt.Sprintf("https://audittrails.api.cloud.yandex.net/audit-trails/v1/trails/%s", url.PathEscape(trailID))
+	var respBody AuditTrail
+	if err := c.apiGet(ctx, urlStr, &respBody); err != nil {
+		return nil, err
+	}
+	return &respBody, nil
+}
@@ -1,6 +1,12 @@
 package yandexcloud
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
 	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
 )
 
@@ -25,5 +31,77 @@ func GetConfig(conn *plugin.Connection) *Config {
 	return nil
 }
 
+// restGet performs a GET request against a Yandex Cloud REST API and decodes the JSON response into out.
+// service names the API in log messages. Status codes in ignoreCodes leave out untouched.
+func restGet(ctx context.Context, httpClient *http.Client, config *Config, token string, service string, urlStr string, out interface{}, ignoreCodes ...int) error {
+	return restDo(ctx, httpClient, config, token, service, http.MethodGet, urlStr, nil, out, ignoreCodes...)
+}
+
+// restPost performs a POST request with a JSON body against a Yandex Cloud REST API and decodes the JSON
+// response into out.
+func restPost(ctx context.Context, httpClient *http.Client, config *Config, token string, service string, urlStr string, in interface{}, out interface{}) error {
+	payload, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return restDo(ctx, httpClient, config, token, service, http.MethodPost, urlStr, payload, out)
+}
+
+// restDo sends an authorized request with the retry count, user agent and endpoint override of the
+// connection config.
+func restDo(ctx context.Context, httpClient *http.Client, config *Config, token string, service string, method string, urlStr string, payload []byte, out interface{}, ignoreCodes ...int) error {
+	LogInfo(ctx, "%s %s: %s", service, method, urlStr)
+	retryCount := 3
+	if config != nil && config.Retry != nil && *config.Retry > 0 {
+		retryCount = *config.Retry
+	}
+	reqFactory := func() *http.Request {
+		var body io.Reader
+		if payload != nil {
+			body = bytes.NewReader(payload)
+		}
+		req, _ := http.NewRequestWithContext(ctx, method, urlStr, body)
+		if payload != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		if config != nil {
+			var ua, eo *string
+			if config.UserAgent != nil {
+				s := string(*config.UserAgent)
+				ua = &s
+			}
+			if config.EndpointOverride != nil {
+				s := string(*config.EndpointOverride)
+				eo = &s
+			}
+			ApplyRequestOptions(req, ua, eo)
+		}
+		return req
+	}
+	resp, err := DoWithRetry(ctx, httpClient, reqFactory, retryCount, int64(httpClient.Timeout.Seconds()))
+	if err != nil {
+		LogError(ctx, "%s %s request failed: %v", service, method, err)
+		return err
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body = io.NopCloser(bytes.NewBuffer(body))
+	if err := HandleHTTPError(resp, ignoreCodes...); err != nil {
+		LogError(ctx, "%s %s HTTP error: %v", service, method, err)
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		// An ignored status code: the response is not the expected resource.
+		return nil
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		LogError(ctx, "%s %s: failed to decode response: %v", service, method, err)
+		return err
+	}
+	LogInfo(ctx, "%s %s success: %s", service, method, urlStr)
+	return nil
+}
+
 // --- KMS Key types and REST client ---
 // (All code related to KMS has been removed. It is now in kms_client.go)
@@ -0,0 +1,54 @@
+package yandexcloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRestRequests(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer tok" || r.Header.Get("User-Agent") != "test-agent" {
+			t.Errorf("unexpected headers: %v", r.Header)
+		}
+		switch r.URL.Path {
+		case "/get":
+			w.Write([]byte(`{"id": "a"}`))
+		case "/post":
+			var in map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&in); err != nil || in["id"] != "b" || r.Header.Get("Content-Type") != "application/json" {
+				t.Errorf("unexpected body: %v %v", in, err)
+			}
+			w.Write([]byte(`{"id": "b"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code": 5, "message": "not found"}`))
+		}
+	}))
+	defer srv.Close()
+	endpoint := EndpointOverride(srv.URL)
+	agent := UserAgent("test-agent")
+	retry := 1
+	cfg := &Config{EndpointOverride: &endpoint, UserAgent: &agent, Retry: &retry}
+	httpClient := GetHTTPClient(5)
+	ctx := context.Background()
+
+	var out struct {
+		Id string `json:"id"`
+	}
+	if err := restGet(ctx, httpClient, cfg, "tok", "Test", "https://api.example.com/get", &out); err != nil || out.Id != "a" {
+		t.Errorf("restGet = %+v, %v", out, err)
+	}
+	if err := restPost(ctx, httpClient, cfg, "tok", "Test", "https://api.example.com/post", map[string]string{"id": "b"}, &out); err != nil || out.Id != "b" {
+		t.Errorf("restPost = %+v, %v", out, err)
+	}
+	out.Id = ""
+	if err := restGet(ctx, httpClient, cfg, "tok", "Test", "https://api.example.com/missing", &out); err == nil {
+		t.Errorf("expected an error for a missing resource")
+	}
+	if err := restGet(ctx, httpClient, cfg, "tok", "Test", "https://api.example.com/missing", &out, http.StatusNotFound); err != nil || out.Id != "" {
+		t.Errorf("ignored status code = %+v, %v", out, err)
+	}
+}
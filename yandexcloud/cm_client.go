@@ -0,0 +1,111 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// --- Certificate Manager types ---
+type CMCertificate struct {
+	Id                 string            `json:"id"`
+	FolderId           string            `json:"folderId"`
+	CreatedAt          string            `json:"createdAt"`
+	Name               string            `json:"name"`
+	Description        string            `json:"description"`
+	Labels             map[string]string `json:"labels"`
+	Type               string            `json:"type"`
+	Domains            []string          `json:"domains"`
+	Status             string            `json:"status"`
+	Issuer             string            `json:"issuer"`
+	Subject            string            `json:"subject"`
+	Serial             string            `json:"serial"`
+	UpdatedAt          string            `json:"updatedAt"`
+	IssuedAt           string            `json:"issuedAt"`
+	NotAfter           string            `json:"notAfter"`
+	NotBefore          string            `json:"notBefore"`
+	Challenges         []CMChallenge     `json:"challenges"`
+	DeletionProtection bool              `json:"deletionProtection"`
+	IncompleteChain    bool              `json:"incompleteChain"`
+}
+
+type CMCertificateID string
+
+// CMChallenge is a domain validation challenge of a managed certificate.
+type CMChallenge struct {
+	Domain        string                 `json:"domain"`
+	Type          string                 `json:"type"`
+	CreatedAt     string                 `json:"createdAt"`
+	UpdatedAt     string                 `json:"updatedAt"`
+	Status        string                 `json:"status"`
+	Message       string                 `json:"message"`
+	Error         string                 `json:"error"`
+	DnsChallenge  map[string]interface{} `json:"dnsChallenge,omitempty"`
+	HttpChallenge map[string]interface{} `json:"httpChallenge,omitempty"`
+}
+
+type ListCMCertificatesResponse struct {
+	Certificates  []*CMCertificate `json:"certificates"`
+	NextPageToken string           `json:"nextPageToken"`
+}
+
+// CMCertificateContent is the public part of the certificate content. The API also returns
+// the private key, which is deliberately not decoded.
+type CMCertificateContent struct {
+	CertificateId    string   `json:"certificateId"`
+	CertificateChain []string `json:"certificateChain"`
+}
+
+type CertificateManagerClient interface {
+	ListCMCertificates(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*CMCertificate, string, error)
+	GetCMCertificateChain(ctx context.Context, certificateID CMCertificateID) ([]string, error)
+}
+
+type yandexCertificateManagerClient struct {
+	token  string
+	http   *http.Client
+	config *Config
+}
+
+func NewCertificateManagerClient(token string, timeoutSec int64, config *Config) CertificateManagerClient {
+	return &yandexCertificateManagerClient{
+		token:  token,
+		http:   GetHTTPClient(timeoutSec),
+		config: config,
+	}
+}
+
+func (c *yandexCertificateManagerClient) apiGet(ctx context.Context, urlStr string, out interface{}) error {
+	return restGet(ctx, c.http, c.config, c.token, "CertificateManager", urlStr, out)
+}
+
+func (c *yandexCertificateManagerClient) ListCMCertificates(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*CMCertificate, string, error) {
+	const endpoint = "https://certificate-manager.api.cloud.yandex.net/certificate-manager/v1/certificates"
+	params := url.Values{}
+	params.Set("folderId", folderID)
+	params.Set("view", "FULL")
+	if pageToken != "" {
+		params.Set("pageToken", pageToken)
+	}
+	if pageSize > 0 {
+		params.Set("pageSize", strconv.FormatInt(pageSize, 10))
+	}
+	var respBody ListCMCertificatesResponse
+	urlStr := fmt.Sprintf("%s?%s", endpoint, params.Encode())
+	if err := c.apiGet(ctx, urlStr, &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.Certificates, respBody.NextPageToken, nil
+}
+
+// GetCMCertificateChain returns the PEM-encoded certificate chain from the data plane API.
+func (c *yandexCertificateManagerClient) GetCMCertificateChain(ctx context.Context, certificateID CMCertificateID) ([]string, error) {
+	urlStr := fmt.Sprintf("https://data.certificate-manager.api.cloud.yandex.net/certificate-manager/v1/certificates/%s:getContent", certificateID)
+	var respBody CMCertificateContent
+	if err := c.apiGet(ctx, urlStr, &respBody); err != nil {
+		return nil, err
+	}
+	return respBody.CertificateChain, nil
+}
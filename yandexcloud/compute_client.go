@@ -29,15 +29,43 @@ type Instance struct {
 	Resources          map[ResourceType]interface{} `json:"resources"`
 	Metadata           map[MetadataKey]string       `json:"metadata"`
 	MetadataOptions    map[string]interface{}       `json:"metadataOptions"`
-	BootDisk           map[string]interface{}       `json:"bootDisk"`
-	SecondaryDisks     []map[string]interface{}     `json:"secondaryDisks"`
-	NetworkInterfaces  []map[string]interface{}     `json:"networkInterfaces"`
+	BootDisk           *AttachedDisk                `json:"bootDisk,omitempty"`
+	SecondaryDisks     []AttachedDisk               `json:"secondaryDisks"`
+	NetworkInterfaces  []NetworkInterface           `json:"networkInterfaces"`
 	FQDN               string                       `json:"fqdn"`
 	ServiceAccountId   ServiceAccountID             `json:"serviceAccountId"`
 	Hostname           Hostname                     `json:"hostname"`
 	DeletionProtection bool                         `json:"deletionProtection"`
 }
 
+type AttachedDisk struct {
+	Mode       string `json:"mode"`
+	DeviceName string `json:"deviceName"`
+	AutoDelete bool   `json:"autoDelete"`
+	DiskId     string `json:"diskId"`
+}
+
+type NetworkInterface struct {
+	Index            string          `json:"index"`
+	MacAddress       string          `json:"macAddress"`
+	SubnetId         string          `json:"subnetId"`
+	PrimaryV4Address *PrimaryAddress `json:"primaryV4Address,omitempty"`
+	PrimaryV6Address *PrimaryAddress `json:"primaryV6Address,omitempty"`
+	SecurityGroupIds []string        `json:"securityGroupIds,omitempty"`
+}
+
+type PrimaryAddress struct {
+	Address     string       `json:"address"`
+	OneToOneNat *OneToOneNat `json:"oneToOneNat,omitempty"`
+	DnsRecords  []DnsRecord  `json:"dnsRecords,omitempty"`
+}
+
+type OneToOneNat struct {
+	Address    string      `json:"address"`
+	IpVersion  string      `json:"ipVersion"`
+	DnsRecords []DnsRecord `json:"dnsRecords,omitempty"`
+}
+
 type InstanceID string
 type FolderID string
 type RetryCount int
@@ -87,6 +115,9 @@ type ComputeClient interface {
 	ListHostTypes(ctx context.Context, zoneID string, pageToken PageToken, pageSize PageSize, timeout TimeoutSec, retry RetryCount) ([]*HostType, PageToken, error)
 	ListOperations(ctx context.Context, folderID FolderID, filter Filter, pageToken PageToken, pageSize PageSize, timeout TimeoutSec, retry RetryCount) ([]*Operation, PageToken, error)
 	GetOperation(ctx context.Context, operationID OperationID, timeout TimeoutSec, retry RetryCount) (*Operation, error)
+	ListInstanceGroups(ctx context.Context, folderID FolderID, filter Filter, pageToken PageToken, pageSize PageSize, timeout TimeoutSec, retry RetryCount) ([]*InstanceGroup, PageToken, error)
+	GetInstanceGroup(ctx context.Context, instanceGroupID InstanceGroupID, timeout TimeoutSec, retry RetryCount) (*InstanceGroup, error)
+	ListInstanceGroupInstances(ctx context.Context, instanceGroupID InstanceGroupID, filter Filter, pageToken PageToken, pageSize PageSize, timeout TimeoutSec, retry RetryCount) ([]*ManagedInstance, PageToken, error)
 	Token() string
 }
 
@@ -163,6 +194,7 @@ type Disk struct {
 	SourceImageId    string            `json:"sourceImageId"`
 	SourceSnapshotId string            `json:"sourceSnapshotId"`
 	BlockSize        string            `json:"blockSize"`
+	InstanceIds      []string          `json:"instanceIds"`
 	Labels           map[string]string `json:"labels"`
 }
 
@@ -401,6 +433,77 @@ type GetOperationResponse struct {
 	Operation *Operation `json:"operation"`
 }
 
+type InstanceGroup struct {
+	Id                    string                 `json:"id"`
+	FolderId              string                 `json:"folderId"`
+	CreatedAt             string                 `json:"createdAt"`
+	Name                  string                 `json:"name"`
+	Description           string                 `json:"description"`
+	Labels                map[string]string      `json:"labels"`
+	InstanceTemplate      map[string]interface{} `json:"instanceTemplate"`
+	ScalePolicy           map[string]interface{} `json:"scalePolicy"`
+	DeployPolicy          map[string]interface{} `json:"deployPolicy"`
+	AllocationPolicy      *AllocationPolicy      `json:"allocationPolicy,omitempty"`
+	LoadBalancerState     *LoadBalancerState     `json:"loadBalancerState,omitempty"`
+	ManagedInstancesState *ManagedInstancesState `json:"managedInstancesState,omitempty"`
+	LoadBalancerSpec      map[string]interface{} `json:"loadBalancerSpec"`
+	HealthChecksSpec      map[string]interface{} `json:"healthChecksSpec"`
+	ServiceAccountId      string                 `json:"serviceAccountId"`
+	Status                string                 `json:"status"`
+	DeletionProtection    bool                   `json:"deletionProtection"`
+}
+
+type AllocationPolicy struct {
+	Zones []AllocationZone `json:"zones"`
+}
+
+type AllocationZone struct {
+	ZoneId string `json:"zoneId"`
+}
+
+type LoadBalancerState struct {
+	TargetGroupId string `json:"targetGroupId"`
+	StatusMessage string `json:"statusMessage"`
+}
+
+// ManagedInstancesState holds the instance counters reported by the instance group.
+// Counts are int64 values, which the REST API encodes as strings.
+type ManagedInstancesState struct {
+	TargetSize           int64 `json:"targetSize,string"`
+	RunningActualCount   int64 `json:"runningActualCount,string"`
+	RunningOutdatedCount int64 `json:"runningOutdatedCount,string"`
+	ProcessingCount      int64 `json:"processingCount,string"`
+}
+
+type InstanceGroupID string
+
+type ListInstanceGroupsResponse struct {
+	InstanceGroups []*InstanceGroup `json:"instanceGroups"`
+	NextPageToken  string           `json:"nextPageToken"`
+}
+
+// ManagedInstance is an instance managed by an instance group.
+// InstanceGroupId and FolderId are not part of the API response, they are set by the caller.
+type ManagedInstance struct {
+	Id                string                   `json:"id"`
+	InstanceGroupId   string                   `json:"-"`
+	FolderId          string                   `json:"-"`
+	Status            string                   `json:"status"`
+	InstanceId        string                   `json:"instanceId"`
+	Fqdn              string                   `json:"fqdn"`
+	Name              string                   `json:"name"`
+	StatusMessage     string                   `json:"statusMessage"`
+	ZoneId            string                   `json:"zoneId"`
+	NetworkInterfaces []map[string]interface{} `json:"networkInterfaces"`
+	StatusChangedAt   string                   `json:"statusChangedAt"`
+	InstanceTag       string                   `json:"instanceTag"`
+}
+
+type ListInstanceGroupInstancesResponse struct {
+	Instances     []*ManagedInstance `json:"instances"`
+	NextPageToken string             `json:"nextPageToken"`
+}
+
 type yandexComputeClient struct {
 	iamToken string
 	http     *http.Client
@@ -878,3 +981,57 @@ func (c *yandexComputeClient) GetOperation(ctx context.Context, id OperationID,
 	}
 	return respBody.Operation, nil
 }
+
+func (c *yandexComputeClient) ListInstanceGroups(ctx context.Context, folderID FolderID, filter Filter, pageToken PageToken, pageSize PageSize, timeout TimeoutSec, retry RetryCount) ([]*InstanceGroup, PageToken, error) {
+	const endpoint = "https://compute.api.cloud.yandex.net/compute/v1/instanceGroups"
+	params := url.Values{}
+	params.Set("folderId", string(folderID))
+	params.Set("view", "FULL")
+	if filter != "" {
+		params.Set("filter", string(filter))
+	}
+	if pageToken != "" {
+		params.Set("pageToken", string(pageToken))
+	}
+	if pageSize > 0 {
+		params.Set("pageSize", strconv.FormatInt(int64(pageSize), 10))
+	}
+	var respBody ListInstanceGroupsResponse
+	err := c.apiGet(ctx, fmt.Sprintf("%s?%s", endpoint, params.Encode()), &respBody, timeout, retry)
+	if err != nil {
+		return nil, "", err
+	}
+	return respBody.InstanceGroups, PageToken(respBody.NextPageToken), nil
+}
+
+func (c *yandexComputeClient) GetInstanceGroup(ctx context.Context, id InstanceGroupID, timeout TimeoutSec, retry RetryCount) (*InstanceGroup, error) {
+	urlStr := fmt.Sprintf("https://compute.api.cloud.yandex.net/compute/v1/instanceGroups/%s?view=FULL", id)
+	var respBody InstanceGroup
+	if err := c.apiGet(ctx, urlStr, &respBody, timeout, retry); err != nil {
+		return nil, err
+	}
+	return &respBody, nil
+}
+
+func (c *yandexComputeClient) ListInstanceGroupInstances(ctx context.Context, id InstanceGroupID, filter Filter, pageToken PageToken, pageSize PageSize, timeout TimeoutSec, retry RetryCount) ([]*ManagedInstance, PageToken, error) {
+	endpoint := fmt.Sprintf("https://compute.api.cloud.yandex.net/compute/v1/instanceGroups/%s:listInstances", id)
+	params := url.Values{}
+	if filter != "" {
+		params.Set("filter", string(filter))
+	}
+	if pageToken != "" {
+		params.Set("pageToken", string(pageToken))
+	}
+	if pageSize > 0 {
+		params.Set("pageSize", strconv.FormatInt(int64(pageSize), 10))
+	}
+	var respBody ListInstanceGroupInstancesResponse
+	err := c.apiGet(ctx, fmt.Sprintf("%s?%s", endpoint, params.Encode()), &respBody, timeout, retry)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, mi := range respBody.Instances {
+		mi.InstanceGroupId = string(id)
+	}
+	return respBody.Instances, PageToken(respBody.NextPageToken), nil
+}
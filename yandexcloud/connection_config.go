@@ -12,12 +12,17 @@ func (e ConfigError) Error() string { return string(e) }
 
 // Strict types for identifiers and parameters
 type CloudID string
+type OrganizationID string
 
 // FolderID is declared only here, do not duplicate
 // type FolderID string
 type Token string
 type UserAgent string
 type EndpointOverride string
+type AccessKeyID string
+type SecretAccessKey string
+type YMQEndpoint string
+type StorageEndpoint string
 type LogLevel string
 
 const (
@@ -30,15 +35,22 @@ func connectionConfig() *plugin.ConnectionConfigSchema {
 	return &plugin.ConnectionConfigSchema{
 		NewInstance: func() interface{} { return &Config{} },
 		Schema: map[string]*schema.Attribute{
-			"token":                    {Type: schema.TypeString},
-			"service_account_key_file": {Type: schema.TypeString},
-			"cloud_id":                 {Type: schema.TypeString},
-			"folder_id":                {Type: schema.TypeString},
-			"timeout":                  {Type: schema.TypeInt},
-			"retry":                    {Type: schema.TypeInt},
-			"user_agent":               {Type: schema.TypeString},
-			"endpoint_override":        {Type: schema.TypeString},
-			"log_level":                {Type: schema.TypeString},
+			"token":                           {Type: schema.TypeString},
+			"service_account_key_file":        {Type: schema.TypeString},
+			"cloud_id":                        {Type: schema.TypeString},
+			"folder_id":                       {Type: schema.TypeString},
+			"organization_id":                 {Type: schema.TypeString},
+			"timeout":                         {Type: schema.TypeInt},
+			"retry":                           {Type: schema.TypeInt},
+			"user_agent":                      {Type: schema.TypeString},
+			"endpoint_override":               {Type: schema.TypeString},
+			"log_level":                       {Type: schema.TypeString},
+			"access_key_id":                   {Type: schema.TypeString},
+			"secret_access_key":               {Type: schema.TypeString},
+			"ymq_endpoint":                    {Type: schema.TypeString},
+			"storage_endpoint":                {Type: schema.TypeString},
+			"cm_read_certificate_content":     {Type: schema.TypeBool},
+			"iam_skip_inaccessible_resources": {Type: schema.TypeBool},
 		},
 	}
 }
@@ -48,11 +60,23 @@ type Config struct {
 	ServiceAccountKeyFile *string           `cty:"service_account_key_file"`
 	CloudID               *CloudID          `cty:"cloud_id"`
 	FolderID              *FolderID         `cty:"folder_id"`
+	OrganizationID        *OrganizationID   `cty:"organization_id"`
 	Timeout               *int              `cty:"timeout"`
 	Retry                 *int              `cty:"retry"`
 	UserAgent             *UserAgent        `cty:"user_agent"`
 	EndpointOverride      *EndpointOverride `cty:"endpoint_override"`
 	LogLevel              *LogLevel         `cty:"log_level"`
+	// AccessKeyID and SecretAccessKey are a static access key for the AWS-compatible APIs.
+	AccessKeyID     *AccessKeyID     `cty:"access_key_id"`
+	SecretAccessKey *SecretAccessKey `cty:"secret_access_key"`
+	YMQEndpoint     *YMQEndpoint     `cty:"ymq_endpoint"`
+	StorageEndpoint *StorageEndpoint `cty:"storage_endpoint"`
+	// CMReadCertificateContent allows reading the content of imported certificates, which the API
+	// returns together with the private key.
+	CMReadCertificateContent *bool `cty:"cm_read_certificate_content"`
+	// IAMSkipInaccessibleResources lets yandexcloud_iam_effective_permission skip resources whose
+	// access bindings cannot be read instead of failing.
+	IAMSkipInaccessibleResources *bool `cty:"iam_skip_inaccessible_resources"`
 }
 
 // ValidateConfig checks required and conflicting config parameters.
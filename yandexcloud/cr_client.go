@@ -0,0 +1,210 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// --- Container Registry types ---
+type CRRegistry struct {
+	Id        string            `json:"id"`
+	FolderId  string            `json:"folderId"`
+	Name      string            `json:"name"`
+	Status    string            `json:"status"`
+	Labels    map[string]string `json:"labels"`
+	CreatedAt string            `json:"createdAt"`
+}
+
+type CRRegistryID string
+
+type ListCRRegistriesResponse struct {
+	Registries    []*CRRegistry `json:"registries"`
+	NextPageToken string        `json:"nextPageToken"`
+}
+
+// CRRepository is a repository; its name is prefixed with the registry ID.
+type CRRepository struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type ListCRRepositoriesResponse struct {
+	Repositories  []*CRRepository `json:"repositories"`
+	NextPageToken string          `json:"nextPageToken"`
+}
+
+type CRImage struct {
+	Id             string   `json:"id"`
+	Name           string   `json:"name"`
+	Digest         string   `json:"digest"`
+	CompressedSize int64    `json:"compressedSize,string"`
+	Tags           []string `json:"tags"`
+	CreatedAt      string   `json:"createdAt"`
+}
+
+type CRImageID string
+
+type ListCRImagesResponse struct {
+	Images        []*CRImage `json:"images"`
+	NextPageToken string     `json:"nextPageToken"`
+}
+
+type CRLifecyclePolicy struct {
+	Id           string                   `json:"id"`
+	Name         string                   `json:"name"`
+	RepositoryId string                   `json:"repositoryId"`
+	Description  string                   `json:"description"`
+	Status       string                   `json:"status"`
+	CreatedAt    string                   `json:"createdAt"`
+	Rules        []map[string]interface{} `json:"rules"`
+}
+
+type ListCRLifecyclePoliciesResponse struct {
+	LifecyclePolicies []*CRLifecyclePolicy `json:"lifecyclePolicies"`
+	NextPageToken     string               `json:"nextPageToken"`
+}
+
+type CRScanResult struct {
+	Id              string               `json:"id"`
+	ImageId         string               `json:"imageId"`
+	ScannedAt       string               `json:"scannedAt"`
+	Status          string               `json:"status"`
+	Vulnerabilities CRVulnerabilityStats `json:"vulnerabilities"`
+}
+
+type CRScanResultID string
+
+// CRVulnerabilityStats is the number of vulnerabilities found by a scan per severity.
+type CRVulnerabilityStats struct {
+	Critical   int64 `json:"critical,string"`
+	High       int64 `json:"high,string"`
+	Medium     int64 `json:"medium,string"`
+	Low        int64 `json:"low,string"`
+	Negligible int64 `json:"negligible,string"`
+	Undefined  int64 `json:"undefined,string"`
+}
+
+type CRVulnerability struct {
+	Severity string                  `json:"severity"`
+	Package  *CRPackageVulnerability `json:"package,omitempty"`
+}
+
+type CRPackageVulnerability struct {
+	Name    string `json:"name"`
+	Link    string `json:"link"`
+	Package string `json:"package"`
+	Source  string `json:"source"`
+	Version string `json:"version"`
+	FixedBy string `json:"fixedBy"`
+	Origin  string `json:"origin"`
+	Type    string `json:"type"`
+}
+
+type ListCRVulnerabilitiesResponse struct {
+	Vulnerabilities []*CRVulnerability `json:"vulnerabilities"`
+	NextPageToken   string             `json:"nextPageToken"`
+}
+
+type ContainerRegistryClient interface {
+	ListCRRegistries(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*CRRegistry, string, error)
+	ListCRRepositories(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*CRRepository, string, error)
+	ListCRImages(ctx context.Context, registryID CRRegistryID, pageToken string, pageSize int64) ([]*CRImage, string, error)
+	ListCRLifecyclePolicies(ctx context.Context, registryID CRRegistryID, pageToken string, pageSize int64) ([]*CRLifecyclePolicy, string, error)
+	GetLastCRScanResult(ctx context.Context, imageID CRImageID) (*CRScanResult, error)
+	ListCRVulnerabilities(ctx context.Context, scanResultID CRScanResultID, pageToken string, pageSize int64) ([]*CRVulnerability, string, error)
+	ListCRRegistryAccessBindings(ctx context.Context, registryID CRRegistryID, pageToken string, pageSize int64) ([]*AccessBinding, string, error)
+}
+
+type yandexContainerRegistryClient struct {
+	token  string
+	http   *http.Client
+	config *Config
+}
+
+func NewContainerRegistryClient(token string, timeoutSec int64, config *Config) ContainerRegistryClient {
+	return &yandexContainerRegistryClient{
+		token:  token,
+		http:   GetHTTPClient(timeoutSec),
+		config: config,
+	}
+}
+
+func (c *yandexContainerRegistryClient) apiGet(ctx context.Context, urlStr string, out interface{}, ignoreCodes ...int) error {
+	return restGet(ctx, c.http, c.config, c.token, "ContainerRegistry", urlStr, out, ignoreCodes...)
+}
+
+// crListURL builds the URL of a Container Registry list method.
+func crListURL(resource string, params url.Values, pageToken string, pageSize int64) string {
+	if pageToken != "" {
+		params.Set("pageToken", pageToken)
+	}
+	if pageSize > 0 {
+		params.Set("pageSize", strconv.FormatInt(pageSize, 10))
+	}
+	return fmt.Sprintf("https://container-registry.api.cloud.yandex.net/container-registry/v1/%s?%s", resource, params.Encode())
+}
+
+func (c *yandexContainerRegistryClient) ListCRRegistries(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*CRRegistry, string, error) {
+	var respBody ListCRRegistriesResponse
+	if err := c.apiGet(ctx, crListURL("registries", url.Values{"folderId": {folderID}}, pageToken, pageSize), &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.Registries, respBody.NextPageToken, nil
+}
+
+func (c *yandexContainerRegistryClient) ListCRRepositories(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*CRRepository, string, error) {
+	var respBody ListCRRepositoriesResponse
+	if err := c.apiGet(ctx, crListURL("repositories", url.Values{"folderId": {folderID}}, pageToken, pageSize), &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.Repositories, respBody.NextPageToken, nil
+}
+
+func (c *yandexContainerRegistryClient) ListCRImages(ctx context.Context, registryID CRRegistryID, pageToken string, pageSize int64) ([]*CRImage, string, error) {
+	var respBody ListCRImagesResponse
+	if err := c.apiGet(ctx, crListURL("images", url.Values{"registryId": {string(registryID)}}, pageToken, pageSize), &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.Images, respBody.NextPageToken, nil
+}
+
+func (c *yandexContainerRegistryClient) ListCRLifecyclePolicies(ctx context.Context, registryID CRRegistryID, pageToken string, pageSize int64) ([]*CRLifecyclePolicy, string, error) {
+	var respBody ListCRLifecyclePoliciesResponse
+	if err := c.apiGet(ctx, crListURL("lifecyclePolicies", url.Values{"registryId": {string(registryID)}}, pageToken, pageSize), &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.LifecyclePolicies, respBody.NextPageToken, nil
+}
+
+// GetLastCRScanResult returns the latest vulnerability scan of an image, or nil if it was never scanned.
+func (c *yandexContainerRegistryClient) GetLastCRScanResult(ctx context.Context, imageID CRImageID) (*CRScanResult, error) {
+	urlStr := fmt.Sprintf("https://container-registry.api.cloud.yandex.net/container-registry/v1/scans:getLast?imageId=%s", url.QueryEscape(string(imageID)))
+	var scan CRScanResult
+	if err := c.apiGet(ctx, urlStr, &scan, http.StatusNotFound); err != nil {
+		return nil, err
+	}
+	if scan.Id == "" {
+		return nil, nil
+	}
+	return &scan, nil
+}
+
+func (c *yandexContainerRegistryClient) ListCRVulnerabilities(ctx context.Context, scanResultID CRScanResultID, pageToken string, pageSize int64) ([]*CRVulnerability, string, error) {
+	resource := fmt.Sprintf("scans/%s:listVulnerabilities", scanResultID)
+	var respBody ListCRVulnerabilitiesResponse
+	if err := c.apiGet(ctx, crListURL(resource, url.Values{}, pageToken, pageSize), &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.Vulnerabilities, respBody.NextPageToken, nil
+}
+
+func (c *yandexContainerRegistryClient) ListCRRegistryAccessBindings(ctx context.Context, registryID CRRegistryID, pageToken string, pageSize int64) ([]*AccessBinding, string, error) {
+	var respBody ListAccessBindingsResponse
+	if err := c.apiGet(ctx, crListURL(fmt.Sprintf("registries/%s:listAccessBindings", registryID), url.Values{}, pageToken, pageSize), &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.AccessBindings, respBody.NextPageToken, nil
+}
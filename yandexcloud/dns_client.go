@@ -0,0 +1,123 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// --- DNS Zone types ---
+type DNSZone struct {
+	Id                 string                 `json:"id"`
+	FolderId           string                 `json:"folderId"`
+	CreatedAt          string                 `json:"createdAt"`
+	Name               string                 `json:"name"`
+	Description        string                 `json:"description"`
+	Labels             map[string]string      `json:"labels"`
+	Zone               string                 `json:"zone"`
+	PrivateVisibility  *DNSPrivateVisibility  `json:"privateVisibility,omitempty"`
+	PublicVisibility   map[string]interface{} `json:"publicVisibility,omitempty"`
+	DeletionProtection bool                   `json:"deletionProtection"`
+}
+
+type DNSZoneID string
+
+type DNSPrivateVisibility struct {
+	NetworkIds []string `json:"networkIds"`
+}
+
+type ListDNSZonesResponse struct {
+	DnsZones      []*DNSZone `json:"dnsZones"`
+	NextPageToken string     `json:"nextPageToken"`
+}
+
+type DNSRecordSet struct {
+	Name string   `json:"name"`
+	Type string   `json:"type"`
+	Ttl  int64    `json:"ttl,string"`
+	Data []string `json:"data"`
+}
+
+type ListDNSRecordSetsResponse struct {
+	RecordSets    []*DNSRecordSet `json:"recordSets"`
+	NextPageToken string          `json:"nextPageToken"`
+}
+
+type DNSClient interface {
+	ListDNSZones(ctx context.Context, folderID string, filter string, pageToken string, pageSize int64) ([]*DNSZone, string, error)
+	GetDNSZone(ctx context.Context, zoneID DNSZoneID) (*DNSZone, error)
+	ListDNSRecordSets(ctx context.Context, zoneID DNSZoneID, filter string, pageToken string, pageSize int64) ([]*DNSRecordSet, string, error)
+}
+
+type yandexDNSClient struct {
+	token  string
+	http   *http.Client
+	config *Config
+}
+
+func NewDNSClient(token string, timeoutSec int64, config *Config) DNSClient {
+	return &yandexDNSClient{
+		token:  token,
+		http:   GetHTTPClient(timeoutSec),
+		config: config,
+	}
+}
+
+func (c *yandexDNSClient) apiGet(ctx context.Context, urlStr string, out interface{}) error {
+	return restGet(ctx, c.http, c.config, c.token, "DNS", urlStr, out)
+}
+
+// ListDNSZones lists zones of a folder. The filter supports a condition on name, e.g. `name = "my-zone"`.
+func (c *yandexDNSClient) ListDNSZones(ctx context.Context, folderID string, filter string, pageToken string, pageSize int64) ([]*DNSZone, string, error) {
+	const endpoint = "https://dns.api.cloud.yandex.net/dns/v1/zones"
+	params := url.Values{}
+	params.Set("folderId", folderID)
+	if filter != "" {
+		params.Set("filter", filter)
+	}
+	if pageToken != "" {
+		params.Set("pageToken", pageToken)
+	}
+	if pageSize > 0 {
+		params.Set("pageSize", strconv.FormatInt(pageSize, 10))
+	}
+	var respBody ListDNSZonesResponse
+	urlStr := fmt.Sprintf("%s?%s", endpoint, params.Encode())
+	if err := c.apiGet(ctx, urlStr, &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.DnsZones, respBody.NextPageToken, nil
+}
+
+func (c *yandexDNSClient) GetDNSZone(ctx context.Context, zoneID DNSZoneID) (*DNSZone, error) {
+	urlStr := fmt.Sprintf("https://dns.api.cloud.yandex.net/dns/v1/zones/%s", zoneID)
+	var zone DNSZone
+	if err := c.apiGet(ctx, urlStr, &zone); err != nil {
+		return nil, err
+	}
+	return &zone, nil
+}
+
+// ListDNSRecordSets lists record sets of a zone. The filter supports conditions on name and type,
+// e.g. `name = "www" AND type = "A"`.
+func (c *yandexDNSClient) ListDNSRecordSets(ctx context.Context, zoneID DNSZoneID, filter string, pageToken string, pageSize int64) ([]*DNSRecordSet, string, error) {
+	endpoint := fmt.Sprintf("https://dns.api.cloud.yandex.net/dns/v1/zones/%s:listRecordSets", zoneID)
+	params := url.Values{}
+	if filter != "" {
+		params.Set("filter", filter)
+	}
+	if pageToken != "" {
+		params.Set("pageToken", pageToken)
+	}
+	if pageSize > 0 {
+		params.Set("pageSize", strconv.FormatInt(pageSize, 10))
+	}
+	var respBody ListDNSRecordSetsResponse
+	urlStr := fmt.Sprintf("%s?%s", endpoint, params.Encode())
+	if err := c.apiGet(ctx, urlStr, &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.RecordSets, respBody.NextPageToken, nil
+}
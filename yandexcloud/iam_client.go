@@ -0,0 +1,60 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// --- IAM types ---
+type IAMRole struct {
+	Id          string `json:"id"`
+	Description string `json:"description"`
+}
+
+type ListIAMRolesResponse struct {
+	Roles         []*IAMRole `json:"roles"`
+	NextPageToken string     `json:"nextPageToken"`
+}
+
+type IAMClient interface {
+	ListIAMRoles(ctx context.Context, pageToken string, pageSize int64) ([]*IAMRole, string, error)
+}
+
+type yandexIAMClient struct {
+	token  string
+	http   *http.Client
+	config *Config
+}
+
+func NewIAMClient(token string, timeoutSec int64, config *Config) IAMClient {
+	return &yandexIAMClient{
+		token:  token,
+		http:   GetHTTPClient(timeoutSec),
+		config: config,
+	}
+}
+
+func (c *yandexIAMClient) apiGet(ctx context.Context, urlStr string, out interface{}) error {
+	return restGet(ctx, c.http, c.config, c.token, "IAM", urlStr, out)
+}
+
+// ListIAMRoles lists the predefined roles.
+func (c *yandexIAMClient) ListIAMRoles(ctx context.Context, pageToken string, pageSize int64) ([]*IAMRole, string, error) {
+	const endpoint = "https://iam.api.cloud.yandex.net/iam/v1/roles"
+	params := url.Values{}
+	if pageToken != "" {
+		params.Set("pageToken", pageToken)
+	}
+	if pageSize > 0 {
+		params.Set("pageSize", strconv.FormatInt(pageSize, 10))
+	}
+	var respBody ListIAMRolesResponse
+	urlStr := fmt.Sprintf("%s?%s", endpoint, params.Encode())
+	if err := c.apiGet(ctx, urlStr, &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.Roles, respBody.NextPageToken, nil
+}
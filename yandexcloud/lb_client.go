@@ -0,0 +1,163 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// --- Network Load Balancer types ---
+type NetworkLoadBalancer struct {
+	Id                   string                `json:"id"`
+	FolderId             string                `json:"folderId"`
+	CreatedAt            string                `json:"createdAt"`
+	Name                 string                `json:"name"`
+	Description          string                `json:"description"`
+	Labels               map[string]string     `json:"labels"`
+	RegionId             string                `json:"regionId"`
+	Status               string                `json:"status"`
+	Type                 string                `json:"type"`
+	SessionAffinity      string                `json:"sessionAffinity"`
+	Listeners            []NLBListener         `json:"listeners"`
+	AttachedTargetGroups []AttachedTargetGroup `json:"attachedTargetGroups"`
+	DeletionProtection   bool                  `json:"deletionProtection"`
+	AllowZonalShift      bool                  `json:"allowZonalShift"`
+}
+
+type NetworkLoadBalancerID string
+
+type NLBListener struct {
+	Name       string `json:"name"`
+	Address    string `json:"address"`
+	Port       int64  `json:"port,string"`
+	Protocol   string `json:"protocol"`
+	TargetPort int64  `json:"targetPort,string"`
+	SubnetId   string `json:"subnetId,omitempty"`
+	IpVersion  string `json:"ipVersion"`
+}
+
+type AttachedTargetGroup struct {
+	TargetGroupId string        `json:"targetGroupId"`
+	HealthChecks  []HealthCheck `json:"healthChecks"`
+}
+
+type HealthCheck struct {
+	Name               string                 `json:"name"`
+	Interval           string                 `json:"interval"`
+	Timeout            string                 `json:"timeout"`
+	UnhealthyThreshold int64                  `json:"unhealthyThreshold,string"`
+	HealthyThreshold   int64                  `json:"healthyThreshold,string"`
+	TcpOptions         map[string]interface{} `json:"tcpOptions,omitempty"`
+	HttpOptions        map[string]interface{} `json:"httpOptions,omitempty"`
+}
+
+type ListNetworkLoadBalancersResponse struct {
+	NetworkLoadBalancers []*NetworkLoadBalancer `json:"networkLoadBalancers"`
+	NextPageToken        string                 `json:"nextPageToken"`
+}
+
+// TargetState is the health of a target in a target group attached to a network load balancer.
+type TargetState struct {
+	SubnetId string `json:"subnetId"`
+	Address  string `json:"address"`
+	Status   string `json:"status"`
+}
+
+type GetTargetStatesResponse struct {
+	TargetStates []*TargetState `json:"targetStates"`
+}
+
+// --- Target Group types ---
+type LBTargetGroup struct {
+	Id          string            `json:"id"`
+	FolderId    string            `json:"folderId"`
+	CreatedAt   string            `json:"createdAt"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Labels      map[string]string `json:"labels"`
+	RegionId    string            `json:"regionId"`
+	Targets     []LBTarget        `json:"targets"`
+}
+
+type LBTarget struct {
+	SubnetId string `json:"subnetId"`
+	Address  string `json:"address"`
+}
+
+type ListLBTargetGroupsResponse struct {
+	TargetGroups  []*LBTargetGroup `json:"targetGroups"`
+	NextPageToken string           `json:"nextPageToken"`
+}
+
+type LoadBalancerClient interface {
+	ListNetworkLoadBalancers(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*NetworkLoadBalancer, string, error)
+	GetTargetStates(ctx context.Context, networkLoadBalancerID NetworkLoadBalancerID, targetGroupID string) ([]*TargetState, error)
+	ListLBTargetGroups(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*LBTargetGroup, string, error)
+}
+
+type yandexLoadBalancerClient struct {
+	token  string
+	http   *http.Client
+	config *Config
+}
+
+func NewLoadBalancerClient(token string, timeoutSec int64, config *Config) LoadBalancerClient {
+	return &yandexLoadBalancerClient{
+		token:  token,
+		http:   GetHTTPClient(timeoutSec),
+		config: config,
+	}
+}
+
+func (c *yandexLoadBalancerClient) apiGet(ctx context.Context, urlStr string, out interface{}) error {
+	return restGet(ctx, c.http, c.config, c.token, "LoadBalancer", urlStr, out)
+}
+
+func (c *yandexLoadBalancerClient) ListNetworkLoadBalancers(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*NetworkLoadBalancer, string, error) {
+	const endpoint = "https://load-balancer.api.cloud.yandex.net/load-balancer/v1/networkLoadBalancers"
+	params := url.Values{}
+	params.Set("folderId", folderID)
+	if pageToken != "" {
+		params.Set("pageToken", pageToken)
+	}
+	if pageSize > 0 {
+		params.Set("pageSize", strconv.FormatInt(pageSize, 10))
+	}
+	var respBody ListNetworkLoadBalancersResponse
+	urlStr := fmt.Sprintf("%s?%s", endpoint, params.Encode())
+	if err := c.apiGet(ctx, urlStr, &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.NetworkLoadBalancers, respBody.NextPageToken, nil
+}
+
+func (c *yandexLoadBalancerClient) GetTargetStates(ctx context.Context, networkLoadBalancerID NetworkLoadBalancerID, targetGroupID string) ([]*TargetState, error) {
+	params := url.Values{}
+	params.Set("targetGroupId", targetGroupID)
+	urlStr := fmt.Sprintf("https://load-balancer.api.cloud.yandex.net/load-balancer/v1/networkLoadBalancers/%s:getTargetStates?%s", networkLoadBalancerID, params.Encode())
+	var respBody GetTargetStatesResponse
+	if err := c.apiGet(ctx, urlStr, &respBody); err != nil {
+		return nil, err
+	}
+	return respBody.TargetStates, nil
+}
+
+func (c *yandexLoadBalancerClient) ListLBTargetGroups(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*LBTargetGroup, string, error) {
+	const endpoint = "https://load-balancer.api.cloud.yandex.net/load-balancer/v1/targetGroups"
+	params := url.Values{}
+	params.Set("folderId", folderID)
+	if pageToken != "" {
+		params.Set("pageToken", pageToken)
+	}
+	if pageSize > 0 {
+		params.Set("pageSize", strconv.FormatInt(pageSize, 10))
+	}
+	var respBody ListLBTargetGroupsResponse
+	urlStr := fmt.Sprintf("%s?%s", endpoint, params.Encode())
+	if err := c.apiGet(ctx, urlStr, &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.TargetGroups, respBody.NextPageToken, nil
+}
@@ -0,0 +1,123 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// --- Lockbox types ---
+// Only secret metadata is modelled. The client never calls the payload API.
+type LockboxSecret struct {
+	Id                           string                 `json:"id"`
+	FolderId                     string                 `json:"folderId"`
+	CreatedAt                    string                 `json:"createdAt"`
+	Name                         string                 `json:"name"`
+	Description                  string                 `json:"description"`
+	Labels                       map[string]string      `json:"labels"`
+	KmsKeyId                     string                 `json:"kmsKeyId"`
+	Status                       string                 `json:"status"`
+	CurrentVersion               *LockboxSecretVersion  `json:"currentVersion,omitempty"`
+	DeletionProtection           bool                   `json:"deletionProtection"`
+	PasswordPayloadSpecification map[string]interface{} `json:"passwordPayloadSpecification,omitempty"`
+}
+
+type LockboxSecretID string
+
+type LockboxSecretVersion struct {
+	Id               string   `json:"id"`
+	SecretId         string   `json:"secretId"`
+	CreatedAt        string   `json:"createdAt"`
+	DestroyAt        string   `json:"destroyAt"`
+	Description      string   `json:"description"`
+	Status           string   `json:"status"`
+	PayloadEntryKeys []string `json:"payloadEntryKeys"`
+}
+
+type ListLockboxSecretsResponse struct {
+	Secrets       []*LockboxSecret `json:"secrets"`
+	NextPageToken string           `json:"nextPageToken"`
+}
+
+type ListLockboxSecretVersionsResponse struct {
+	Versions      []*LockboxSecretVersion `json:"versions"`
+	NextPageToken string                  `json:"nextPageToken"`
+}
+
+type LockboxClient interface {
+	ListLockboxSecrets(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*LockboxSecret, string, error)
+	ListLockboxSecretVersions(ctx context.Context, secretID LockboxSecretID, pageToken string, pageSize int64) ([]*LockboxSecretVersion, string, error)
+	ListLockboxSecretAccessBindings(ctx context.Context, secretID LockboxSecretID, pageToken string, pageSize int64) ([]*AccessBinding, string, error)
+}
+
+type yandexLockboxClient struct {
+	token  string
+	http   *http.Client
+	config *Config
+}
+
+func NewLockboxClient(token string, timeoutSec int64, config *Config) LockboxClient {
+	return &yandexLockboxClient{
+		token:  token,
+		http:   GetHTTPClient(timeoutSec),
+		config: config,
+	}
+}
+
+func (c *yandexLockboxClient) apiGet(ctx context.Context, urlStr string, out interface{}) error {
+	return restGet(ctx, c.http, c.config, c.token, "Lockbox", urlStr, out)
+}
+
+func (c *yandexLockboxClient) ListLockboxSecrets(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*LockboxSecret, string, error) {
+	const endpoint = "https://lockbox.api.cloud.yandex.net/lockbox/v1/secrets"
+	params := url.Values{}
+	params.Set("folderId", folderID)
+	if pageToken != "" {
+		params.Set("pageToken", pageToken)
+	}
+	if pageSize > 0 {
+		params.Set("pageSize", strconv.FormatInt(pageSize, 10))
+	}
+	var respBody ListLockboxSecretsResponse
+	urlStr := fmt.Sprintf("%s?%s", endpoint, params.Encode())
+	if err := c.apiGet(ctx, urlStr, &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.Secrets, respBody.NextPageToken, nil
+}
+
+func (c *yandexLockboxClient) ListLockboxSecretVersions(ctx context.Context, secretID LockboxSecretID, pageToken string, pageSize int64) ([]*LockboxSecretVersion, string, error) {
+	endpoint := fmt.Sprintf("https://lockbox.api.cloud.yandex.net/lockbox/v1/secrets/%s/versions", secretID)
+	params := url.Values{}
+	if pageToken != "" {
+		params.Set("pageToken", pageToken)
+	}
+	if pageSize > 0 {
+		params.Set("pageSize", strconv.FormatInt(pageSize, 10))
+	}
+	var respBody ListLockboxSecretVersionsResponse
+	urlStr := fmt.Sprintf("%s?%s", endpoint, params.Encode())
+	if err := c.apiGet(ctx, urlStr, &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.Versions, respBody.NextPageToken, nil
+}
+
+func (c *yandexLockboxClient) ListLockboxSecretAccessBindings(ctx context.Context, secretID LockboxSecretID, pageToken string, pageSize int64) ([]*AccessBinding, string, error) {
+	endpoint := fmt.Sprintf("https://lockbox.api.cloud.yandex.net/lockbox/v1/secrets/%s:listAccessBindings", secretID)
+	params := url.Values{}
+	if pageToken != "" {
+		params.Set("pageToken", pageToken)
+	}
+	if pageSize > 0 {
+		params.Set("pageSize", strconv.FormatInt(pageSize, 10))
+	}
+	var respBody ListAccessBindingsResponse
+	urlStr := fmt.Sprintf("%s?%s", endpoint, params.Encode())
+	if err := c.apiGet(ctx, urlStr, &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.AccessBindings, respBody.NextPageToken, nil
+}
@@ -0,0 +1,130 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// --- Cloud Logging types ---
+type LoggingGroup struct {
+	Id              string            `json:"id"`
+	FolderId        string            `json:"folderId"`
+	CloudId         string            `json:"cloudId"`
+	CreatedAt       string            `json:"createdAt"`
+	Name            string            `json:"name"`
+	Description     string            `json:"description"`
+	Labels          map[string]string `json:"labels"`
+	Status          string            `json:"status"`
+	RetentionPeriod string            `json:"retentionPeriod"`
+	DataStream      string            `json:"dataStream"`
+	KmsKeyId        string            `json:"kmsKeyId"`
+}
+
+type ListLoggingGroupsResponse struct {
+	Groups        []*LoggingGroup `json:"groups"`
+	NextPageToken string          `json:"nextPageToken"`
+}
+
+// LoggingEntry is a log entry returned by the Logging Read API.
+type LoggingEntry struct {
+	Uid      string `json:"uid"`
+	Resource struct {
+		Type string `json:"type"`
+		Id   string `json:"id"`
+	} `json:"resource"`
+	Timestamp   string                 `json:"timestamp"`
+	IngestedAt  string                 `json:"ingestedAt"`
+	SavedAt     string                 `json:"savedAt"`
+	Level       string                 `json:"level"`
+	Message     string                 `json:"message"`
+	JsonPayload map[string]interface{} `json:"jsonPayload"`
+	StreamName  string                 `json:"streamName"`
+}
+
+// LoggingReadCriteria selects the entries read from a log group.
+type LoggingReadCriteria struct {
+	LogGroupId    string   `json:"logGroupId"`
+	ResourceTypes []string `json:"resourceTypes,omitempty"`
+	ResourceIds   []string `json:"resourceIds,omitempty"`
+	Since         string   `json:"since,omitempty"`
+	Until         string   `json:"until,omitempty"`
+	Levels        []string `json:"levels,omitempty"`
+	Filter        string   `json:"filter,omitempty"`
+	StreamNames   []string `json:"streamNames,omitempty"`
+	PageSize      int64    `json:"pageSize,omitempty"`
+}
+
+type loggingReadRequest struct {
+	Criteria  *LoggingReadCriteria `json:"criteria,omitempty"`
+	PageToken string               `json:"pageToken,omitempty"`
+}
+
+type loggingReadResponse struct {
+	LogGroupId    string          `json:"logGroupId"`
+	Entries       []*LoggingEntry `json:"entries"`
+	NextPageToken string          `json:"nextPageToken"`
+}
+
+type LoggingClient interface {
+	ListLoggingGroups(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*LoggingGroup, string, error)
+	ReadLoggingEntries(ctx context.Context, criteria *LoggingReadCriteria, pageToken string) ([]*LoggingEntry, string, error)
+}
+
+type yandexLoggingClient struct {
+	token  string
+	http   *http.Client
+	config *Config
+}
+
+func NewLoggingClient(token string, timeoutSec int64, config *Config) LoggingClient {
+	return &yandexLoggingClient{
+		token:  token,
+		http:   GetHTTPClient(timeoutSec),
+		config: config,
+	}
+}
+
+func (c *yandexLoggingClient) apiGet(ctx context.Context, urlStr string, out interface{}) error {
+	return restGet(ctx, c.http, c.config, c.token, "Logging", urlStr, out)
+}
+
+// apiPost sends in as a JSON request body and decodes the JSON response into out.
+func (c *yandexLoggingClient) apiPost(ctx context.Context, urlStr string, in interface{}, out interface{}) error {
+	return restPost(ctx, c.http, c.config, c.token, "Logging", urlStr, in, out)
+}
+
+func (c *yandexLoggingClient) ListLoggingGroups(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*LoggingGroup, string, error) {
+	const endpoint = "https://logging.api.cloud.yandex.net/logging/v1/logGroups"
+	params := url.Values{}
+	params.Set("folderId", folderID)
+	if pageToken != "" {
+		params.Set("pageToken", pageToken)
+	}
+	if pageSize > 0 {
+		params.Set("pageSize", strconv.FormatInt(pageSize, 10))
+	}
+	var respBody ListLoggingGroupsResponse
+	urlStr := fmt.Sprintf("%s?%s", endpoint, params.Encode())
+	if err := c.apiGet(ctx, urlStr, &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.Groups, respBody.NextPageToken, nil
+}
+
+// ReadLoggingEntries reads a page of entries from the Logging Read API. The criteria are sent
+// for the first page only; later pages are selected by the page token.
+func (c *yandexLoggingClient) ReadLoggingEntries(ctx context.Context, criteria *LoggingReadCriteria, pageToken string) ([]*LoggingEntry, string, error) {
+	const endpoint = "https://reader.logging.yandexcloud.net/logging/v1/read"
+	reqBody := loggingReadRequest{Criteria: criteria}
+	if pageToken != "" {
+		reqBody = loggingReadRequest{PageToken: pageToken}
+	}
+	var respBody loggingReadResponse
+	if err := c.apiPost(ctx, endpoint, &reqBody, &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.Entries, respBody.NextPageToken, nil
+}
@@ -0,0 +1,194 @@
+package yandexcloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// --- Managed Databases types ---
+
+// MDBCluster holds the fields shared by the clusters of all managed database engines.
+// Engine-specific settings are kept in Config.
+type MDBCluster struct {
+	Id                 string                 `json:"id"`
+	FolderId           string                 `json:"folderId"`
+	CreatedAt          string                 `json:"createdAt"`
+	Name               string                 `json:"name"`
+	Description        string                 `json:"description"`
+	Labels             map[string]string      `json:"labels"`
+	Environment        string                 `json:"environment"`
+	NetworkId          string                 `json:"networkId"`
+	Health             string                 `json:"health"`
+	Status             string                 `json:"status"`
+	SecurityGroupIds   []string               `json:"securityGroupIds"`
+	DeletionProtection bool                   `json:"deletionProtection"`
+	MaintenanceWindow  map[string]interface{} `json:"maintenanceWindow,omitempty"`
+	Config             map[string]interface{} `json:"config,omitempty"`
+}
+
+type MDBClusterID string
+
+type ListMDBClustersResponse struct {
+	Clusters      []*MDBCluster `json:"clusters"`
+	NextPageToken string        `json:"nextPageToken"`
+}
+
+// MDBHost holds the fields shared by the hosts of all managed database engines.
+type MDBHost struct {
+	Name              string                   `json:"name"`
+	ClusterId         string                   `json:"clusterId"`
+	ZoneId            string                   `json:"zoneId"`
+	Resources         *MDBResources            `json:"resources,omitempty"`
+	Role              string                   `json:"role"`
+	Health            string                   `json:"health"`
+	Services          []map[string]interface{} `json:"services"`
+	SubnetId          string                   `json:"subnetId"`
+	AssignPublicIp    bool                     `json:"assignPublicIp"`
+	Type              string                   `json:"type"`
+	ShardName         string                   `json:"shardName"`
+	ReplicationSource string                   `json:"replicationSource"`
+}
+
+type MDBResources struct {
+	ResourcePresetId string `json:"resourcePresetId"`
+	DiskSize         int64  `json:"diskSize,string"`
+	DiskTypeId       string `json:"diskTypeId"`
+}
+
+type ListMDBHostsResponse struct {
+	Hosts         []*MDBHost `json:"hosts"`
+	NextPageToken string     `json:"nextPageToken"`
+}
+
+// KafkaTopic is a topic of a Managed Kafka cluster.
+type KafkaTopic struct {
+	Name              string      `json:"name"`
+	ClusterId         string      `json:"clusterId"`
+	Partitions        json.Number `json:"partitions"`
+	ReplicationFactor json.Number `json:"replicationFactor"`
+	// Config holds the topic settings, which the API returns under a version-specific key
+	// such as topicConfig_3.
+	Config map[string]interface{} `json:"-"`
+}
+
+func (t *KafkaTopic) UnmarshalJSON(data []byte) error {
+	type plainKafkaTopic KafkaTopic
+	if err := json.Unmarshal(data, (*plainKafkaTopic)(t)); err != nil {
+		return err
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	for key, value := range raw {
+		if strings.HasPrefix(key, "topicConfig") {
+			return json.Unmarshal(value, &t.Config)
+		}
+	}
+	return nil
+}
+
+type ListKafkaTopicsResponse struct {
+	Topics        []*KafkaTopic `json:"topics"`
+	NextPageToken string        `json:"nextPageToken"`
+}
+
+type KafkaUser struct {
+	Name        string                `json:"name"`
+	ClusterId   string                `json:"clusterId"`
+	Permissions []KafkaUserPermission `json:"permissions"`
+}
+
+type KafkaUserPermission struct {
+	TopicName  string   `json:"topicName"`
+	Role       string   `json:"role"`
+	AllowHosts []string `json:"allowHosts"`
+}
+
+type ListKafkaUsersResponse struct {
+	Users         []*KafkaUser `json:"users"`
+	NextPageToken string       `json:"nextPageToken"`
+}
+
+// MDBClient lists clusters and hosts of a managed database service, e.g. managed-mysql,
+// and the topics and users of Managed Kafka clusters.
+type MDBClient interface {
+	ListMDBClusters(ctx context.Context, service string, folderID string, filter string, pageToken string, pageSize int64) ([]*MDBCluster, string, error)
+	ListMDBHosts(ctx context.Context, service string, clusterID MDBClusterID, pageToken string, pageSize int64) ([]*MDBHost, string, error)
+	ListKafkaTopics(ctx context.Context, clusterID MDBClusterID, pageToken string, pageSize int64) ([]*KafkaTopic, string, error)
+	ListKafkaUsers(ctx context.Context, clusterID MDBClusterID, pageToken string, pageSize int64) ([]*KafkaUser, string, error)
+}
+
+type yandexMDBClient struct {
+	token  string
+	http   *http.Client
+	config *Config
+}
+
+func NewMDBClient(token string, timeoutSec int64, config *Config) MDBClient {
+	return &yandexMDBClient{
+		token:  token,
+		http:   GetHTTPClient(timeoutSec),
+		config: config,
+	}
+}
+
+func (c *yandexMDBClient) apiGet(ctx context.Context, urlStr string, out interface{}) error {
+	return restGet(ctx, c.http, c.config, c.token, "MDB", urlStr, out)
+}
+
+// mdbURL builds the URL of a managed database service method.
+func mdbURL(service string, resource string, params url.Values, pageToken string, pageSize int64) string {
+	if pageToken != "" {
+		params.Set("pageToken", pageToken)
+	}
+	if pageSize > 0 {
+		params.Set("pageSize", strconv.FormatInt(pageSize, 10))
+	}
+	return fmt.Sprintf("https://mdb.api.cloud.yandex.net/%s/v1/%s?%s", service, resource, params.Encode())
+}
+
+// ListMDBClusters lists clusters of a folder. The filter supports a condition on name, e.g. `name = "db1"`.
+func (c *yandexMDBClient) ListMDBClusters(ctx context.Context, service string, folderID string, filter string, pageToken string, pageSize int64) ([]*MDBCluster, string, error) {
+	var respBody ListMDBClustersResponse
+	params := url.Values{"folderId": {folderID}}
+	if filter != "" {
+		params.Set("filter", filter)
+	}
+	if err := c.apiGet(ctx, mdbURL(service, "clusters", params, pageToken, pageSize), &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.Clusters, respBody.NextPageToken, nil
+}
+
+func (c *yandexMDBClient) ListMDBHosts(ctx context.Context, service string, clusterID MDBClusterID, pageToken string, pageSize int64) ([]*MDBHost, string, error) {
+	var respBody ListMDBHostsResponse
+	resource := fmt.Sprintf("clusters/%s/hosts", clusterID)
+	if err := c.apiGet(ctx, mdbURL(service, resource, url.Values{}, pageToken, pageSize), &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.Hosts, respBody.NextPageToken, nil
+}
+
+func (c *yandexMDBClient) ListKafkaTopics(ctx context.Context, clusterID MDBClusterID, pageToken string, pageSize int64) ([]*KafkaTopic, string, error) {
+	var respBody ListKafkaTopicsResponse
+	resource := fmt.Sprintf("clusters/%s/topics", clusterID)
+	if err := c.apiGet(ctx, mdbURL(mdbEngineKafka.Service, resource, url.Values{}, pageToken, pageSize), &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.Topics, respBody.NextPageToken, nil
+}
+
+func (c *yandexMDBClient) ListKafkaUsers(ctx context.Context, clusterID MDBClusterID, pageToken string, pageSize int64) ([]*KafkaUser, string, error) {
+	var respBody ListKafkaUsersResponse
+	resource := fmt.Sprintf("clusters/%s/users", clusterID)
+	if err := c.apiGet(ctx, mdbURL(mdbEngineKafka.Service, resource, url.Values{}, pageToken, pageSize), &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.Users, respBody.NextPageToken, nil
+}
@@ -0,0 +1,118 @@
+package yandexcloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// --- Monitoring types ---
+
+// MonitoringMetric is a metric with its label set, as returned by the metrics list API.
+type MonitoringMetric struct {
+	Name   string            `json:"name"`
+	Labels map[string]string `json:"labels"`
+	Type   string            `json:"type"`
+}
+
+type ListMonitoringMetricsResponse struct {
+	Metrics       []*MonitoringMetric `json:"metrics"`
+	NextPageToken string              `json:"nextPageToken"`
+}
+
+// MonitoringDownsampling selects how points are aggregated; only one of MaxPoints,
+// GridInterval and Disabled is sent.
+type MonitoringDownsampling struct {
+	MaxPoints       int64  `json:"maxPoints,omitempty"`
+	GridInterval    int64  `json:"gridInterval,omitempty"`
+	GridAggregation string `json:"gridAggregation,omitempty"`
+	Disabled        bool   `json:"disabled,omitempty"`
+}
+
+type MonitoringReadRequest struct {
+	Query        string                  `json:"query"`
+	FromTime     string                  `json:"fromTime"`
+	ToTime       string                  `json:"toTime"`
+	Downsampling *MonitoringDownsampling `json:"downsampling,omitempty"`
+}
+
+// MonitoringTimeSeries is a metric returned by a data read. Timestamps are milliseconds since
+// the epoch; int64 values are encoded as strings and NaN double values as "NaN".
+type MonitoringTimeSeries struct {
+	Name       string            `json:"name"`
+	Labels     map[string]string `json:"labels"`
+	Type       string            `json:"type"`
+	Timeseries struct {
+		Timestamps   []json.Number `json:"timestamps"`
+		DoubleValues []interface{} `json:"doubleValues"`
+		Int64Values  []interface{} `json:"int64Values"`
+	} `json:"timeseries"`
+}
+
+type monitoringReadResponse struct {
+	Metrics []*MonitoringTimeSeries `json:"metrics"`
+}
+
+type MonitoringClient interface {
+	ListMonitoringMetrics(ctx context.Context, folderID string, selectors string, pageToken string, pageSize int64) ([]*MonitoringMetric, string, error)
+	ReadMonitoringData(ctx context.Context, folderID string, request *MonitoringReadRequest) ([]*MonitoringTimeSeries, error)
+}
+
+type yandexMonitoringClient struct {
+	token  string
+	http   *http.Client
+	config *Config
+}
+
+func NewMonitoringClient(token string, timeoutSec int64, config *Config) MonitoringClient {
+	return &yandexMonitoringClient{
+		token:  token,
+		http:   GetHTTPClient(timeoutSec),
+		config: config,
+	}
+}
+
+func (c *yandexMonitoringClient) apiGet(ctx context.Context, urlStr string, out interface{}) error {
+	return restGet(ctx, c.http, c.config, c.token, "Monitoring", urlStr, out)
+}
+
+// apiPost sends in as a JSON request body and decodes the JSON response into out.
+func (c *yandexMonitoringClient) apiPost(ctx context.Context, urlStr string, in interface{}, out interface{}) error {
+	return restPost(ctx, c.http, c.config, c.token, "Monitoring", urlStr, in, out)
+}
+
+func (c *yandexMonitoringClient) ListMonitoringMetrics(ctx context.Context, folderID string, selectors string, pageToken string, pageSize int64) ([]*MonitoringMetric, string, error) {
+	const endpoint = "https://monitoring.api.cloud.yandex.net/monitoring/v2/metrics/"
+	params := url.Values{}
+	params.Set("folderId", folderID)
+	if selectors != "" {
+		params.Set("selectors", selectors)
+	}
+	if pageToken != "" {
+		params.Set("pageToken", pageToken)
+	}
+	if pageSize > 0 {
+		params.Set("pageSize", strconv.FormatInt(pageSize, 10))
+	}
+	var respBody ListMonitoringMetricsResponse
+	urlStr := fmt.Sprintf("%s?%s", endpoint, params.Encode())
+	if err := c.apiGet(ctx, urlStr, &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.Metrics, respBody.NextPageToken, nil
+}
+
+func (c *yandexMonitoringClient) ReadMonitoringData(ctx context.Context, folderID string, request *MonitoringReadRequest) ([]*MonitoringTimeSeries, error) {
+	const endpoint = "https://monitoring.api.cloud.yandex.net/monitoring/v2/data/read"
+	params := url.Values{}
+	params.Set("folderId", folderID)
+	var respBody monitoringReadResponse
+	urlStr := fmt.Sprintf("%s?%s", endpoint, params.Encode())
+	if err := c.apiPost(ctx, urlStr, request, &respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Metrics, nil
+}
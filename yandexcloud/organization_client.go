@@ -0,0 +1,240 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const organizationManagerEndpoint = "https://organization-manager.api.cloud.yandex.net/organization-manager/v1"
+
+// --- Organization Manager types ---
+type Organization struct {
+	Id          string            `json:"id"`
+	CreatedAt   string            `json:"createdAt"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Title       string            `json:"title"`
+	Labels      map[string]string `json:"labels"`
+}
+
+type ListOrganizationsResponse struct {
+	Organizations []*Organization `json:"organizations"`
+	NextPageToken string          `json:"nextPageToken"`
+}
+
+// SAMLFederation is a SAML identity federation of an organization.
+type SAMLFederation struct {
+	Id                       string            `json:"id"`
+	OrganizationId           string            `json:"organizationId"`
+	CreatedAt                string            `json:"createdAt"`
+	Name                     string            `json:"name"`
+	Description              string            `json:"description"`
+	Labels                   map[string]string `json:"labels"`
+	CookieMaxAge             string            `json:"cookieMaxAge"`
+	AutoCreateAccountOnLogin bool              `json:"autoCreateAccountOnLogin"`
+	CaseInsensitiveNameIds   bool              `json:"caseInsensitiveNameIds"`
+	Issuer                   string            `json:"issuer"`
+	SsoBinding               string            `json:"ssoBinding"`
+	SsoUrl                   string            `json:"ssoUrl"`
+	SecuritySettings         struct {
+		EncryptedAssertions bool `json:"encryptedAssertions"`
+		ForceAuthn          bool `json:"forceAuthn"`
+	} `json:"securitySettings"`
+}
+
+type ListSAMLFederationsResponse struct {
+	Federations   []*SAMLFederation `json:"federations"`
+	NextPageToken string            `json:"nextPageToken"`
+}
+
+// SAMLCertificate is a certificate the federation uses to verify IdP signatures.
+type SAMLCertificate struct {
+	Id           string `json:"id"`
+	FederationId string `json:"federationId"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	CreatedAt    string `json:"createdAt"`
+	Data         string `json:"data"`
+}
+
+type ListSAMLCertificatesResponse struct {
+	Certificates  []*SAMLCertificate `json:"certificates"`
+	NextPageToken string             `json:"nextPageToken"`
+}
+
+// OrganizationUser is a member of an organization described by its OpenID subject claims.
+type OrganizationUser struct {
+	SubjectClaims struct {
+		Sub               string `json:"sub"`
+		Name              string `json:"name"`
+		GivenName         string `json:"given_name"`
+		FamilyName        string `json:"family_name"`
+		PreferredUsername string `json:"preferred_username"`
+		Email             string `json:"email"`
+		PhoneNumber       string `json:"phone_number"`
+		SubType           string `json:"sub_type"`
+		Federation        *struct {
+			Id   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"federation,omitempty"`
+	} `json:"subjectClaims"`
+}
+
+type ListOrganizationUsersResponse struct {
+	Users         []*OrganizationUser `json:"users"`
+	NextPageToken string              `json:"nextPageToken"`
+}
+
+type OrganizationGroup struct {
+	Id             string `json:"id"`
+	OrganizationId string `json:"organizationId"`
+	CreatedAt      string `json:"createdAt"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+}
+
+type ListOrganizationGroupsResponse struct {
+	Groups        []*OrganizationGroup `json:"groups"`
+	NextPageToken string               `json:"nextPageToken"`
+}
+
+type OrganizationGroupMember struct {
+	SubjectId   string `json:"subjectId"`
+	SubjectType string `json:"subjectType"`
+}
+
+type ListOrganizationGroupMembersResponse struct {
+	Members       []*OrganizationGroupMember `json:"members"`
+	NextPageToken string                     `json:"nextPageToken"`
+}
+
+// AccessBinding grants a role to a subject on a resource.
+type AccessBinding struct {
+	RoleId  string `json:"roleId"`
+	Subject struct {
+		Id   string `json:"id"`
+		Type string `json:"type"`
+	} `json:"subject"`
+}
+
+type ListAccessBindingsResponse struct {
+	AccessBindings []*AccessBinding `json:"accessBindings"`
+	NextPageToken  string           `json:"nextPageToken"`
+}
+
+type OrganizationClient interface {
+	ListOrganizations(ctx context.Context, pageToken string, pageSize int64) ([]*Organization, string, error)
+	ListSAMLFederations(ctx context.Context, organizationID string, pageToken string, pageSize int64) ([]*SAMLFederation, string, error)
+	ListSAMLCertificates(ctx context.Context, federationID string, pageToken string, pageSize int64) ([]*SAMLCertificate, string, error)
+	ListOrganizationUsers(ctx context.Context, organizationID string, pageToken string, pageSize int64) ([]*OrganizationUser, string, error)
+	ListOrganizationGroups(ctx context.Context, organizationID string, pageToken string, pageSize int64) ([]*OrganizationGroup, string, error)
+	ListOrganizationGroupMembers(ctx context.Context, groupID string, pageToken string, pageSize int64) ([]*OrganizationGroupMember, string, error)
+	ListOrganizationAccessBindings(ctx context.Context, organizationID string, pageToken string, pageSize int64) ([]*AccessBinding, string, error)
+}
+
+type yandexOrganizationClient struct {
+	token  string
+	http   *http.Client
+	config *Config
+}
+
+func NewOrganizationClient(token string, timeoutSec int64, config *Config) OrganizationClient {
+	return &yandexOrganizationClient{
+		token:  token,
+		http:   GetHTTPClient(timeoutSec),
+		config: config,
+	}
+}
+
+func (c *yandexOrganizationClient) apiGet(ctx context.Context, urlStr string, out interface{}) error {
+	return restGet(ctx, c.http, c.config, c.token, "Organization", urlStr, out)
+}
+
+// organizationListURL builds a list URL with paging parameters.
+func organizationListURL(endpoint string, params url.Values, pageToken string, pageSize int64) string {
+	if params == nil {
+		params = url.Values{}
+	}
+	if pageToken != "" {
+		params.Set("pageToken", pageToken)
+	}
+	if pageSize > 0 {
+		params.Set("pageSize", strconv.FormatInt(pageSize, 10))
+	}
+	if len(params) == 0 {
+		return endpoint
+	}
+	return fmt.Sprintf("%s?%s", endpoint, params.Encode())
+}
+
+func (c *yandexOrganizationClient) ListOrganizations(ctx context.Context, pageToken string, pageSize int64) ([]*Organization, string, error) {
+	var respBody ListOrganizationsResponse
+	urlStr := organizationListURL(organizationManagerEndpoint+"/organizations", nil, pageToken, pageSize)
+	if err := c.apiGet(ctx, urlStr, &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.Organizations, respBody.NextPageToken, nil
+}
+
+func (c *yandexOrganizationClient) ListSAMLFederations(ctx context.Context, organizationID string, pageToken string, pageSize int64) ([]*SAMLFederation, string, error) {
+	params := url.Values{}
+	params.Set("organizationId", organizationID)
+	var respBody ListSAMLFederationsResponse
+	urlStr := organizationListURL(organizationManagerEndpoint+"/saml/federations", params, pageToken, pageSize)
+	if err := c.apiGet(ctx, urlStr, &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.Federations, respBody.NextPageToken, nil
+}
+
+func (c *yandexOrganizationClient) ListSAMLCertificates(ctx context.Context, federationID string, pageToken string, pageSize int64) ([]*SAMLCertificate, string, error) {
+	params := url.Values{}
+	params.Set("federationId", federationID)
+	var respBody ListSAMLCertificatesResponse
+	urlStr := organizationListURL(organizationManagerEndpoint+"/saml/certificates", params, pageToken, pageSize)
+	if err := c.apiGet(ctx, urlStr, &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.Certificates, respBody.NextPageToken, nil
+}
+
+func (c *yandexOrganizationClient) ListOrganizationUsers(ctx context.Context, organizationID string, pageToken string, pageSize int64) ([]*OrganizationUser, string, error) {
+	var respBody ListOrganizationUsersResponse
+	urlStr := organizationListURL(fmt.Sprintf("%s/organizations/%s/users", organizationManagerEndpoint, url.PathEscape(organizationID)), nil, pageToken, pageSize)
+	if err := c.apiGet(ctx, urlStr, &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.Users, respBody.NextPageToken, nil
+}
+
+func (c *yandexOrganizationClient) ListOrganizationGroups(ctx context.Context, organizationID string, pageToken string, pageSize int64) ([]*OrganizationGroup, string, error) {
+	params := url.Values{}
+	params.Set("organizationId", organizationID)
+	var respBody ListOrganizationGroupsResponse
+	urlStr := organizationListURL(organizationManagerEndpoint+"/groups", params, pageToken, pageSize)
+	if err := c.apiGet(ctx, urlStr, &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.Groups, respBody.NextPageToken, nil
+}
+
+func (c *yandexOrganizationClient) ListOrganizationGroupMembers(ctx context.Context, groupID string, pageToken string, pageSize int64) ([]*OrganizationGroupMember, string, error) {
+	var respBody ListOrganizationGroupMembersResponse
+	urlStr := organizationListURL(fmt.Sprintf("%s/groups/%s:listMembers", organizationManagerEndpoint, url.PathEscape(groupID)), nil, pageToken, pageSize)
+	if err := c.apiGet(ctx, urlStr, &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.Members, respBody.NextPageToken, nil
+}
+
+func (c *yandexOrganizationClient) ListOrganizationAccessBindings(ctx context.Context, organizationID string, pageToken string, pageSize int64) ([]*AccessBinding, string, error) {
+	var respBody ListAccessBindingsResponse
+	urlStr := organizationListURL(fmt.Sprintf("%s/organizations/%s:listAccessBindings", organizationManagerEndpoint, url.PathEscape(organizationID)), nil, pageToken, pageSize)
+	if err := c.apiGet(ctx, urlStr, &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.AccessBindings, respBody.NextPageToken, nil
+}
@@ -13,32 +13,98 @@ func Plugin() *plugin.Plugin {
 		Name:                   "yandexcloud",
 		ConnectionConfigSchema: connectionConfig(),
 		TableMap: map[string]*plugin.Table{
-			"yandexcloud_compute_instance":               tableYandexComputeInstance(ctx),
-			"yandexcloud_billing_resource_usage":         tableYandexBillingResourceUsage(ctx),
-			"yandexcloud_compute_snapshot":               tableYandexComputeSnapshot(ctx),
-			"yandexcloud_compute_image":                  tableYandexComputeImage(ctx),
-			"yandexcloud_compute_disk":                   tableYandexComputeDisk(ctx),
-			"yandexcloud_compute_filesystem":             tableYandexComputeFilesystem(ctx),
-			"yandexcloud_compute_placement_group":        tableYandexComputePlacementGroup(ctx),
-			"yandexcloud_compute_host_group":             tableYandexComputeHostGroup(ctx),
-			"yandexcloud_compute_gpu_cluster":            tableYandexComputeGPUCluster(ctx),
-			"yandexcloud_compute_disk_placement_group":   tableYandexComputeDiskPlacementGroup(ctx),
-			"yandexcloud_compute_snapshot_schedule":      tableYandexComputeSnapshotSchedule(ctx),
-			"yandexcloud_compute_reserved_instance_pool": tableYandexComputeReservedInstancePool(ctx),
-			"yandexcloud_compute_zone":                   tableYandexComputeZone(ctx),
-			"yandexcloud_compute_disk_type":              tableYandexComputeDiskType(ctx),
-			"yandexcloud_compute_host_type":              tableYandexComputeHostType(ctx),
-			"yandexcloud_compute_operation":              tableYandexComputeOperation(ctx),
-			"yandexcloud_vpc_network":                    tableYandexVPCNetwork(ctx),
-			"yandexcloud_vpc_subnet":                     tableYandexVPCSubnet(ctx),
-			"yandexcloud_vpc_route_table":                tableYandexVPCRouteTable(ctx),
-			"yandexcloud_vpc_security_group":             tableYandexVPCSecurityGroup(ctx),
-			"yandexcloud_vpc_address":                    tableYandexVPCAddress(ctx),
-			"yandexcloud_vpc_gateway":                    tableYandexVPCGateway(ctx),
-			"yandexcloud_vpc_operation":                  tableYandexVPCOperation(ctx),
-			"yandexcloud_billing_account":                tableYandexBillingAccount(ctx),
-			"yandexcloud_billing_sku":                    tableYandexBillingSku(ctx),
-			"yandexcloud_billing_budget":                 tableYandexBillingBudget(ctx),
+			"yandexcloud_compute_instance":                   tableYandexComputeInstance(ctx),
+			"yandexcloud_billing_resource_usage":             tableYandexBillingResourceUsage(ctx),
+			"yandexcloud_compute_snapshot":                   tableYandexComputeSnapshot(ctx),
+			"yandexcloud_compute_image":                      tableYandexComputeImage(ctx),
+			"yandexcloud_compute_disk":                       tableYandexComputeDisk(ctx),
+			"yandexcloud_compute_filesystem":                 tableYandexComputeFilesystem(ctx),
+			"yandexcloud_compute_placement_group":            tableYandexComputePlacementGroup(ctx),
+			"yandexcloud_compute_host_group":                 tableYandexComputeHostGroup(ctx),
+			"yandexcloud_compute_gpu_cluster":                tableYandexComputeGPUCluster(ctx),
+			"yandexcloud_compute_disk_placement_group":       tableYandexComputeDiskPlacementGroup(ctx),
+			"yandexcloud_compute_snapshot_schedule":          tableYandexComputeSnapshotSchedule(ctx),
+			"yandexcloud_compute_reserved_instance_pool":     tableYandexComputeReservedInstancePool(ctx),
+			"yandexcloud_compute_zone":                       tableYandexComputeZone(ctx),
+			"yandexcloud_compute_disk_type":                  tableYandexComputeDiskType(ctx),
+			"yandexcloud_compute_host_type":                  tableYandexComputeHostType(ctx),
+			"yandexcloud_compute_operation":                  tableYandexComputeOperation(ctx),
+			"yandexcloud_compute_instance_group":             tableYandexComputeInstanceGroup(ctx),
+			"yandexcloud_compute_instance_group_instance":    tableYandexComputeInstanceGroupInstance(ctx),
+			"yandexcloud_compute_instance_network_interface": tableYandexComputeInstanceNetworkInterface(ctx),
+			"yandexcloud_compute_instance_disk_attachment":   tableYandexComputeInstanceDiskAttachment(ctx),
+			"yandexcloud_vpc_network":                        tableYandexVPCNetwork(ctx),
+			"yandexcloud_vpc_subnet":                         tableYandexVPCSubnet(ctx),
+			"yandexcloud_vpc_subnet_used_address":            tableYandexVPCSubnetUsedAddress(ctx),
+			"yandexcloud_vpc_route_table":                    tableYandexVPCRouteTable(ctx),
+			"yandexcloud_vpc_route_table_route":              tableYandexVPCRouteTableRoute(ctx),
+			"yandexcloud_vpc_security_group":                 tableYandexVPCSecurityGroup(ctx),
+			"yandexcloud_vpc_security_group_rule":            tableYandexVPCSecurityGroupRule(ctx),
+			"yandexcloud_vpc_address":                        tableYandexVPCAddress(ctx),
+			"yandexcloud_vpc_gateway":                        tableYandexVPCGateway(ctx),
+			"yandexcloud_vpc_private_endpoint":               tableYandexVPCPrivateEndpoint(ctx),
+			"yandexcloud_vpc_operation":                      tableYandexVPCOperation(ctx),
+			"yandexcloud_network_exposure":                   tableYandexNetworkExposure(ctx),
+			"yandexcloud_lb_network_load_balancer":           tableYandexLBNetworkLoadBalancer(ctx),
+			"yandexcloud_lb_target_group":                    tableYandexLBTargetGroup(ctx),
+			"yandexcloud_lb_target_state":                    tableYandexLBTargetState(ctx),
+			"yandexcloud_alb_load_balancer":                  tableYandexALBLoadBalancer(ctx),
+			"yandexcloud_alb_listener":                       tableYandexALBListener(ctx),
+			"yandexcloud_alb_http_router":                    tableYandexALBHttpRouter(ctx),
+			"yandexcloud_alb_virtual_host":                   tableYandexALBVirtualHost(ctx),
+			"yandexcloud_alb_route":                          tableYandexALBRoute(ctx),
+			"yandexcloud_alb_backend_group":                  tableYandexALBBackendGroup(ctx),
+			"yandexcloud_alb_target_group":                   tableYandexALBTargetGroup(ctx),
+			"yandexcloud_cm_certificate":                     tableYandexCMCertificate(ctx),
+			"yandexcloud_dns_zone":                           tableYandexDNSZone(ctx),
+			"yandexcloud_dns_record_set":                     tableYandexDNSRecordSet(ctx),
+			"yandexcloud_lockbox_secret":                     tableYandexLockboxSecret(ctx),
+			"yandexcloud_lockbox_secret_version":             tableYandexLockboxSecretVersion(ctx),
+			"yandexcloud_serverless_function":                tableYandexServerlessFunction(ctx),
+			"yandexcloud_serverless_function_version":        tableYandexServerlessFunctionVersion(ctx),
+			"yandexcloud_serverless_trigger":                 tableYandexServerlessTrigger(ctx),
+			"yandexcloud_serverless_container":               tableYandexServerlessContainer(ctx),
+			"yandexcloud_api_gateway":                        tableYandexAPIGateway(ctx),
+			"yandexcloud_cr_registry":                        tableYandexCRRegistry(ctx),
+			"yandexcloud_cr_repository":                      tableYandexCRRepository(ctx),
+			"yandexcloud_cr_image":                           tableYandexCRImage(ctx),
+			"yandexcloud_cr_lifecycle_policy":                tableYandexCRLifecyclePolicy(ctx),
+			"yandexcloud_cr_scan_result":                     tableYandexCRScanResult(ctx),
+			"yandexcloud_cr_vulnerability":                   tableYandexCRVulnerability(ctx),
+			"yandexcloud_mdb_postgresql_cluster":             tableYandexMDBPostgreSQLCluster(ctx),
+			"yandexcloud_mdb_postgresql_host":                tableYandexMDBPostgreSQLHost(ctx),
+			"yandexcloud_mdb_mysql_cluster":                  tableYandexMDBMySQLCluster(ctx),
+			"yandexcloud_mdb_mysql_host":                     tableYandexMDBMySQLHost(ctx),
+			"yandexcloud_mdb_clickhouse_cluster":             tableYandexMDBClickHouseCluster(ctx),
+			"yandexcloud_mdb_clickhouse_host":                tableYandexMDBClickHouseHost(ctx),
+			"yandexcloud_mdb_redis_cluster":                  tableYandexMDBRedisCluster(ctx),
+			"yandexcloud_mdb_redis_host":                     tableYandexMDBRedisHost(ctx),
+			"yandexcloud_mdb_mongodb_cluster":                tableYandexMDBMongoDBCluster(ctx),
+			"yandexcloud_mdb_mongodb_host":                   tableYandexMDBMongoDBHost(ctx),
+			"yandexcloud_mdb_kafka_cluster":                  tableYandexMDBKafkaCluster(ctx),
+			"yandexcloud_mdb_kafka_host":                     tableYandexMDBKafkaHost(ctx),
+			"yandexcloud_mdb_kafka_topic":                    tableYandexMDBKafkaTopic(ctx),
+			"yandexcloud_mdb_kafka_user":                     tableYandexMDBKafkaUser(ctx),
+			"yandexcloud_mdb_host":                           tableYandexMDBHost(ctx),
+			"yandexcloud_ydb_database":                       tableYandexYDBDatabase(ctx),
+			"yandexcloud_ymq_queue":                          tableYandexYMQQueue(ctx),
+			"yandexcloud_audittrails_trail":                  tableYandexAuditTrailsTrail(ctx),
+			"yandexcloud_audit_event":                        tableYandexAuditEvent(ctx),
+			"yandexcloud_logging_group":                      tableYandexLoggingGroup(ctx),
+			"yandexcloud_logging_entry":                      tableYandexLoggingEntry(ctx),
+			"yandexcloud_monitoring_metric":                  tableYandexMonitoringMetric(ctx),
+			"yandexcloud_monitoring_metric_descriptor":       tableYandexMonitoringMetricDescriptor(ctx),
+			"yandexcloud_organization":                       tableYandexOrganization(ctx),
+			"yandexcloud_organization_saml_federation":       tableYandexOrganizationSAMLFederation(ctx),
+			"yandexcloud_organization_user":                  tableYandexOrganizationUser(ctx),
+			"yandexcloud_organization_group":                 tableYandexOrganizationGroup(ctx),
+			"yandexcloud_organization_group_member":          tableYandexOrganizationGroupMember(ctx),
+			"yandexcloud_organization_access_binding":        tableYandexOrganizationAccessBinding(ctx),
+			"yandexcloud_iam_effective_permission":           tableYandexIAMEffectivePermission(ctx),
+			"yandexcloud_iam_role":                           tableYandexIAMRole(ctx),
+			"yandexcloud_billing_account":                    tableYandexBillingAccount(ctx),
+			"yandexcloud_billing_sku":                        tableYandexBillingSku(ctx),
+			"yandexcloud_billing_budget":                     tableYandexBillingBudget(ctx),
 		},
 	}
 }
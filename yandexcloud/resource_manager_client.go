@@ -0,0 +1,112 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// --- Resource Manager types ---
+type ResourceManagerCloud struct {
+	Id             string            `json:"id"`
+	OrganizationId string            `json:"organizationId"`
+	CreatedAt      string            `json:"createdAt"`
+	Name           string            `json:"name"`
+	Description    string            `json:"description"`
+	Labels         map[string]string `json:"labels"`
+}
+
+type ListResourceManagerCloudsResponse struct {
+	Clouds        []*ResourceManagerCloud `json:"clouds"`
+	NextPageToken string                  `json:"nextPageToken"`
+}
+
+type ResourceManagerFolder struct {
+	Id          string            `json:"id"`
+	CloudId     string            `json:"cloudId"`
+	CreatedAt   string            `json:"createdAt"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Labels      map[string]string `json:"labels"`
+	Status      string            `json:"status"`
+}
+
+type ListResourceManagerFoldersResponse struct {
+	Folders       []*ResourceManagerFolder `json:"folders"`
+	NextPageToken string                   `json:"nextPageToken"`
+}
+
+type ResourceManagerClient interface {
+	ListClouds(ctx context.Context, organizationID string, pageToken string, pageSize int64) ([]*ResourceManagerCloud, string, error)
+	ListFolders(ctx context.Context, cloudID string, pageToken string, pageSize int64) ([]*ResourceManagerFolder, string, error)
+	ListCloudAccessBindings(ctx context.Context, cloudID string, pageToken string, pageSize int64) ([]*AccessBinding, string, error)
+	ListFolderAccessBindings(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*AccessBinding, string, error)
+}
+
+type yandexResourceManagerClient struct {
+	token  string
+	http   *http.Client
+	config *Config
+}
+
+func NewResourceManagerClient(token string, timeoutSec int64, config *Config) ResourceManagerClient {
+	return &yandexResourceManagerClient{
+		token:  token,
+		http:   GetHTTPClient(timeoutSec),
+		config: config,
+	}
+}
+
+func (c *yandexResourceManagerClient) apiGet(ctx context.Context, urlStr string, out interface{}) error {
+	return restGet(ctx, c.http, c.config, c.token, "ResourceManager", urlStr, out)
+}
+
+// resourceManagerURL builds a Resource Manager URL with paging parameters.
+func resourceManagerURL(resource string, params url.Values, pageToken string, pageSize int64) string {
+	if pageToken != "" {
+		params.Set("pageToken", pageToken)
+	}
+	if pageSize > 0 {
+		params.Set("pageSize", strconv.FormatInt(pageSize, 10))
+	}
+	return fmt.Sprintf("https://resource-manager.api.cloud.yandex.net/resource-manager/v1/%s?%s", resource, params.Encode())
+}
+
+// ListClouds lists the clouds of an organization, or all clouds available to the caller when organizationID is empty.
+func (c *yandexResourceManagerClient) ListClouds(ctx context.Context, organizationID string, pageToken string, pageSize int64) ([]*ResourceManagerCloud, string, error) {
+	params := url.Values{}
+	if organizationID != "" {
+		params.Set("organizationId", organizationID)
+	}
+	var respBody ListResourceManagerCloudsResponse
+	if err := c.apiGet(ctx, resourceManagerURL("clouds", params, pageToken, pageSize), &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.Clouds, respBody.NextPageToken, nil
+}
+
+func (c *yandexResourceManagerClient) ListFolders(ctx context.Context, cloudID string, pageToken string, pageSize int64) ([]*ResourceManagerFolder, string, error) {
+	var respBody ListResourceManagerFoldersResponse
+	if err := c.apiGet(ctx, resourceManagerURL("folders", url.Values{"cloudId": {cloudID}}, pageToken, pageSize), &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.Folders, respBody.NextPageToken, nil
+}
+
+func (c *yandexResourceManagerClient) ListCloudAccessBindings(ctx context.Context, cloudID string, pageToken string, pageSize int64) ([]*AccessBinding, string, error) {
+	var respBody ListAccessBindingsResponse
+	if err := c.apiGet(ctx, resourceManagerURL(fmt.Sprintf("clouds/%s:listAccessBindings", url.PathEscape(cloudID)), url.Values{}, pageToken, pageSize), &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.AccessBindings, respBody.NextPageToken, nil
+}
+
+func (c *yandexResourceManagerClient) ListFolderAccessBindings(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*AccessBinding, string, error) {
+	var respBody ListAccessBindingsResponse
+	if err := c.apiGet(ctx, resourceManagerURL(fmt.Sprintf("folders/%s:listAccessBindings", url.PathEscape(folderID)), url.Values{}, pageToken, pageSize), &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.AccessBindings, respBody.NextPageToken, nil
+}
@@ -0,0 +1,329 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// --- Serverless Function types ---
+type ServerlessFunction struct {
+	Id            string            `json:"id"`
+	FolderId      string            `json:"folderId"`
+	CreatedAt     string            `json:"createdAt"`
+	Name          string            `json:"name"`
+	Description   string            `json:"description"`
+	Labels        map[string]string `json:"labels"`
+	LogGroupId    string            `json:"logGroupId"`
+	HttpInvokeUrl string            `json:"httpInvokeUrl"`
+	Status        string            `json:"status"`
+}
+
+type ServerlessFunctionID string
+
+type ListServerlessFunctionsResponse struct {
+	Functions     []*ServerlessFunction `json:"functions"`
+	NextPageToken string                `json:"nextPageToken"`
+}
+
+type ServerlessFunctionVersion struct {
+	Id               string                   `json:"id"`
+	FunctionId       string                   `json:"functionId"`
+	Description      string                   `json:"description"`
+	CreatedAt        string                   `json:"createdAt"`
+	Runtime          string                   `json:"runtime"`
+	Entrypoint       string                   `json:"entrypoint"`
+	Resources        *ServerlessResources     `json:"resources,omitempty"`
+	ExecutionTimeout string                   `json:"executionTimeout"`
+	ServiceAccountId string                   `json:"serviceAccountId"`
+	ImageSize        int64                    `json:"imageSize,string"`
+	Status           string                   `json:"status"`
+	Tags             []string                 `json:"tags"`
+	Environment      map[string]string        `json:"environment"`
+	Connectivity     *ServerlessConnectivity  `json:"connectivity,omitempty"`
+	Secrets          []ServerlessSecret       `json:"secrets"`
+	LogOptions       map[string]interface{}   `json:"logOptions,omitempty"`
+	Concurrency      int64                    `json:"concurrency,string"`
+	StorageMounts    []map[string]interface{} `json:"storageMounts"`
+}
+
+type ServerlessResources struct {
+	Memory       int64 `json:"memory,string"`
+	Cores        int64 `json:"cores,string"`
+	CoreFraction int64 `json:"coreFraction,string"`
+}
+
+type ServerlessConnectivity struct {
+	NetworkId string   `json:"networkId"`
+	SubnetId  []string `json:"subnetId"`
+}
+
+// ServerlessSecret is a reference to a Lockbox secret exposed to a function or container.
+type ServerlessSecret struct {
+	Id                  string `json:"id"`
+	VersionId           string `json:"versionId"`
+	Key                 string `json:"key"`
+	EnvironmentVariable string `json:"environmentVariable"`
+}
+
+type ListServerlessFunctionVersionsResponse struct {
+	Versions      []*ServerlessFunctionVersion `json:"versions"`
+	NextPageToken string                       `json:"nextPageToken"`
+}
+
+type ListServerlessRuntimesResponse struct {
+	Runtimes []string `json:"runtimes"`
+}
+
+// --- Serverless Trigger types ---
+type ServerlessTrigger struct {
+	Id          string                 `json:"id"`
+	FolderId    string                 `json:"folderId"`
+	CreatedAt   string                 `json:"createdAt"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Labels      map[string]string      `json:"labels"`
+	Rule        map[string]interface{} `json:"rule"`
+	Status      string                 `json:"status"`
+}
+
+type ListServerlessTriggersResponse struct {
+	Triggers      []*ServerlessTrigger `json:"triggers"`
+	NextPageToken string               `json:"nextPageToken"`
+}
+
+// --- Serverless Container types ---
+type ServerlessContainer struct {
+	Id          string            `json:"id"`
+	FolderId    string            `json:"folderId"`
+	CreatedAt   string            `json:"createdAt"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Labels      map[string]string `json:"labels"`
+	Url         string            `json:"url"`
+	Status      string            `json:"status"`
+}
+
+type ListServerlessContainersResponse struct {
+	Containers    []*ServerlessContainer `json:"containers"`
+	NextPageToken string                 `json:"nextPageToken"`
+}
+
+type ServerlessContainerRevision struct {
+	Id               string                        `json:"id"`
+	ContainerId      string                        `json:"containerId"`
+	Description      string                        `json:"description"`
+	CreatedAt        string                        `json:"createdAt"`
+	Image            *ServerlessContainerImage     `json:"image,omitempty"`
+	Resources        *ServerlessResources          `json:"resources,omitempty"`
+	ExecutionTimeout string                        `json:"executionTimeout"`
+	Concurrency      int64                         `json:"concurrency,string"`
+	ServiceAccountId string                        `json:"serviceAccountId"`
+	Status           string                        `json:"status"`
+	Secrets          []ServerlessSecret            `json:"secrets"`
+	Connectivity     *ServerlessConnectivity       `json:"connectivity,omitempty"`
+	ProvisionPolicy  *ServerlessContainerProvision `json:"provisionPolicy,omitempty"`
+	ScalingPolicy    map[string]interface{}        `json:"scalingPolicy,omitempty"`
+	LogOptions       map[string]interface{}        `json:"logOptions,omitempty"`
+}
+
+type ServerlessContainerImage struct {
+	ImageUrl    string            `json:"imageUrl"`
+	ImageDigest string            `json:"imageDigest"`
+	Environment map[string]string `json:"environment"`
+	WorkingDir  string            `json:"workingDir"`
+}
+
+type ServerlessContainerProvision struct {
+	MinInstances int64 `json:"minInstances,string"`
+}
+
+type ListServerlessContainerRevisionsResponse struct {
+	Revisions     []*ServerlessContainerRevision `json:"revisions"`
+	NextPageToken string                         `json:"nextPageToken"`
+}
+
+// --- API Gateway types ---
+type APIGateway struct {
+	Id               string                  `json:"id"`
+	FolderId         string                  `json:"folderId"`
+	CreatedAt        string                  `json:"createdAt"`
+	Name             string                  `json:"name"`
+	Description      string                  `json:"description"`
+	Labels           map[string]string       `json:"labels"`
+	Status           string                  `json:"status"`
+	Domain           string                  `json:"domain"`
+	LogGroupId       string                  `json:"logGroupId"`
+	AttachedDomains  []APIGatewayDomain      `json:"attachedDomains"`
+	Connectivity     *ServerlessConnectivity `json:"connectivity,omitempty"`
+	LogOptions       map[string]interface{}  `json:"logOptions,omitempty"`
+	ExecutionTimeout string                  `json:"executionTimeout"`
+}
+
+type APIGatewayID string
+
+type APIGatewayDomain struct {
+	DomainId      string `json:"domainId"`
+	CertificateId string `json:"certificateId"`
+	Enabled       bool   `json:"enabled"`
+	Domain        string `json:"domain"`
+}
+
+type ListAPIGatewaysResponse struct {
+	ApiGateways   []*APIGateway `json:"apiGateways"`
+	NextPageToken string        `json:"nextPageToken"`
+}
+
+type APIGatewayOpenapiSpec struct {
+	ApiGatewayId string `json:"apiGatewayId"`
+	OpenapiSpec  string `json:"openapiSpec"`
+}
+
+type ServerlessClient interface {
+	ListServerlessFunctions(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*ServerlessFunction, string, error)
+	GetServerlessFunction(ctx context.Context, functionID ServerlessFunctionID) (*ServerlessFunction, error)
+	ListServerlessFunctionVersions(ctx context.Context, folderID string, functionID ServerlessFunctionID, pageToken string, pageSize int64) ([]*ServerlessFunctionVersion, string, error)
+	ListServerlessFunctionAccessBindings(ctx context.Context, functionID ServerlessFunctionID, pageToken string, pageSize int64) ([]*AccessBinding, string, error)
+	ListServerlessRuntimes(ctx context.Context) ([]string, error)
+	ListServerlessTriggers(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*ServerlessTrigger, string, error)
+	ListServerlessContainers(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*ServerlessContainer, string, error)
+	ListServerlessContainerRevisions(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*ServerlessContainerRevision, string, error)
+	ListAPIGateways(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*APIGateway, string, error)
+	GetAPIGatewayOpenapiSpec(ctx context.Context, gatewayID APIGatewayID) (string, error)
+}
+
+type yandexServerlessClient struct {
+	token  string
+	http   *http.Client
+	config *Config
+}
+
+func NewServerlessClient(token string, timeoutSec int64, config *Config) ServerlessClient {
+	return &yandexServerlessClient{
+		token:  token,
+		http:   GetHTTPClient(timeoutSec),
+		config: config,
+	}
+}
+
+func (c *yandexServerlessClient) apiGet(ctx context.Context, urlStr string, out interface{}) error {
+	return restGet(ctx, c.http, c.config, c.token, "Serverless", urlStr, out)
+}
+
+// serverlessListURL builds the URL of a list method with the given filter parameters.
+func serverlessListURL(endpoint string, params url.Values, pageToken string, pageSize int64) string {
+	if pageToken != "" {
+		params.Set("pageToken", pageToken)
+	}
+	if pageSize > 0 {
+		params.Set("pageSize", strconv.FormatInt(pageSize, 10))
+	}
+	return fmt.Sprintf("%s?%s", endpoint, params.Encode())
+}
+
+func (c *yandexServerlessClient) ListServerlessFunctions(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*ServerlessFunction, string, error) {
+	const endpoint = "https://serverless-functions.api.cloud.yandex.net/functions/v1/functions"
+	var respBody ListServerlessFunctionsResponse
+	urlStr := serverlessListURL(endpoint, url.Values{"folderId": {folderID}}, pageToken, pageSize)
+	if err := c.apiGet(ctx, urlStr, &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.Functions, respBody.NextPageToken, nil
+}
+
+func (c *yandexServerlessClient) GetServerlessFunction(ctx context.Context, functionID ServerlessFunctionID) (*ServerlessFunction, error) {
+	urlStr := fmt.Sprintf("https://serverless-functions.api.cloud.yandex.net/functions/v1/functions/%s", functionID)
+	var fn ServerlessFunction
+	if err := c.apiGet(ctx, urlStr, &fn); err != nil {
+		return nil, err
+	}
+	return &fn, nil
+}
+
+// ListServerlessFunctionVersions lists versions of a function when functionID is set, and of all functions of the folder otherwise.
+func (c *yandexServerlessClient) ListServerlessFunctionVersions(ctx context.Context, folderID string, functionID ServerlessFunctionID, pageToken string, pageSize int64) ([]*ServerlessFunctionVersion, string, error) {
+	const endpoint = "https://serverless-functions.api.cloud.yandex.net/functions/v1/versions"
+	params := url.Values{}
+	if functionID != "" {
+		params.Set("functionId", string(functionID))
+	} else {
+		params.Set("folderId", folderID)
+	}
+	var respBody ListServerlessFunctionVersionsResponse
+	if err := c.apiGet(ctx, serverlessListURL(endpoint, params, pageToken, pageSize), &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.Versions, respBody.NextPageToken, nil
+}
+
+func (c *yandexServerlessClient) ListServerlessFunctionAccessBindings(ctx context.Context, functionID ServerlessFunctionID, pageToken string, pageSize int64) ([]*AccessBinding, string, error) {
+	endpoint := fmt.Sprintf("https://serverless-functions.api.cloud.yandex.net/functions/v1/functions/%s:listAccessBindings", functionID)
+	var respBody ListAccessBindingsResponse
+	if err := c.apiGet(ctx, serverlessListURL(endpoint, url.Values{}, pageToken, pageSize), &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.AccessBindings, respBody.NextPageToken, nil
+}
+
+// ListServerlessRuntimes returns the runtimes currently available for new function versions.
+func (c *yandexServerlessClient) ListServerlessRuntimes(ctx context.Context) ([]string, error) {
+	const urlStr = "https://serverless-functions.api.cloud.yandex.net/functions/v1/runtimes"
+	var respBody ListServerlessRuntimesResponse
+	if err := c.apiGet(ctx, urlStr, &respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Runtimes, nil
+}
+
+func (c *yandexServerlessClient) ListServerlessTriggers(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*ServerlessTrigger, string, error) {
+	const endpoint = "https://serverless-triggers.api.cloud.yandex.net/triggers/v1/triggers"
+	var respBody ListServerlessTriggersResponse
+	urlStr := serverlessListURL(endpoint, url.Values{"folderId": {folderID}}, pageToken, pageSize)
+	if err := c.apiGet(ctx, urlStr, &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.Triggers, respBody.NextPageToken, nil
+}
+
+func (c *yandexServerlessClient) ListServerlessContainers(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*ServerlessContainer, string, error) {
+	const endpoint = "https://serverless-containers.api.cloud.yandex.net/containers/v1/containers"
+	var respBody ListServerlessContainersResponse
+	urlStr := serverlessListURL(endpoint, url.Values{"folderId": {folderID}}, pageToken, pageSize)
+	if err := c.apiGet(ctx, urlStr, &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.Containers, respBody.NextPageToken, nil
+}
+
+// ListServerlessContainerRevisions lists revisions of all containers of the folder.
+func (c *yandexServerlessClient) ListServerlessContainerRevisions(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*ServerlessContainerRevision, string, error) {
+	const endpoint = "https://serverless-containers.api.cloud.yandex.net/containers/v1/revisions"
+	var respBody ListServerlessContainerRevisionsResponse
+	urlStr := serverlessListURL(endpoint, url.Values{"folderId": {folderID}}, pageToken, pageSize)
+	if err := c.apiGet(ctx, urlStr, &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.Revisions, respBody.NextPageToken, nil
+}
+
+func (c *yandexServerlessClient) ListAPIGateways(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*APIGateway, string, error) {
+	const endpoint = "https://serverless-apigateway.api.cloud.yandex.net/apigateways/v1/apigateways"
+	var respBody ListAPIGatewaysResponse
+	urlStr := serverlessListURL(endpoint, url.Values{"folderId": {folderID}}, pageToken, pageSize)
+	if err := c.apiGet(ctx, urlStr, &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.ApiGateways, respBody.NextPageToken, nil
+}
+
+// GetAPIGatewayOpenapiSpec returns the OpenAPI specification of a gateway.
+func (c *yandexServerlessClient) GetAPIGatewayOpenapiSpec(ctx context.Context, gatewayID APIGatewayID) (string, error) {
+	urlStr := fmt.Sprintf("https://serverless-apigateway.api.cloud.yandex.net/apigateways/v1/apigateways/%s:getOpenapiSpec", gatewayID)
+	var respBody APIGatewayOpenapiSpec
+	if err := c.apiGet(ctx, urlStr, &respBody); err != nil {
+		return "", err
+	}
+	return respBody.OpenapiSpec, nil
+}
@@ -0,0 +1,29 @@
+package yandexcloud
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+// The expected signature is the IAM ListUsers example from the AWS Signature Version 4 documentation.
+func TestSignRequestV4(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "https://iam.amazonaws.com/?Action=ListUsers&Version=2010-05-08", nil)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	creds := &StaticKeyCredentials{AccessKeyID: "AKIDEXAMPLE", SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY"}
+	signRequestV4(req, nil, creds, "us-east-1", "iam", time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC))
+
+	want := "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/iam/aws4_request, SignedHeaders=content-type;host;x-amz-date, Signature=5d672d79c15b13162d9279b0855cfba6789a8edb4c82c400e06b5924a6f2b5d7"
+	if got := req.Header.Get("Authorization"); got != want {
+		t.Errorf("unexpected Authorization header:\n got %s\nwant %s", got, want)
+	}
+	if got := req.Header.Get("X-Amz-Date"); got != "20150830T123600Z" {
+		t.Errorf("unexpected X-Amz-Date: %s", got)
+	}
+}
+
+func TestSigV4Escape(t *testing.T) {
+	if got := sigV4Escape("a b/c~d"); got != "a%20b%2Fc~d" {
+		t.Errorf("unexpected escape: %s", got)
+	}
+}
@@ -0,0 +1,143 @@
+package yandexcloud
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// defaultStorageEndpoint is the S3-compatible endpoint of Object Storage.
+const defaultStorageEndpoint = "https://storage.yandexcloud.net"
+
+// StorageObject is an object returned by ListObjectsV2.
+type StorageObject struct {
+	Key          string `xml:"Key"`
+	LastModified string `xml:"LastModified"`
+	Size         int64  `xml:"Size"`
+}
+
+type storageListObjectsResponse struct {
+	Contents              []*StorageObject `xml:"Contents"`
+	IsTruncated           bool             `xml:"IsTruncated"`
+	NextContinuationToken string           `xml:"NextContinuationToken"`
+}
+
+// StorageClient reads objects through the S3-compatible Object Storage API, which is authenticated with a static access key.
+type StorageClient interface {
+	ListStorageObjects(ctx context.Context, bucket string, prefix string, continuationToken string) ([]*StorageObject, string, error)
+	GetStorageObject(ctx context.Context, bucket string, key string) ([]byte, error)
+}
+
+type yandexStorageClient struct {
+	creds    *StaticKeyCredentials
+	endpoint string
+	http     *http.Client
+	config   *Config
+}
+
+func NewStorageClient(timeoutSec int64, config *Config) (StorageClient, error) {
+	creds, err := getStaticKeyCredentials(config)
+	if err != nil {
+		return nil, err
+	}
+	endpoint := defaultStorageEndpoint
+	if config.StorageEndpoint != nil && *config.StorageEndpoint != "" {
+		endpoint = strings.TrimSuffix(string(*config.StorageEndpoint), "/")
+	}
+	return &yandexStorageClient{
+		creds:    creds,
+		endpoint: endpoint,
+		http:     GetHTTPClient(timeoutSec),
+		config:   config,
+	}, nil
+}
+
+// apiGet sends a signed GET request for a path-style bucket URL and returns the response body.
+func (c *yandexStorageClient) apiGet(ctx context.Context, path string, params url.Values) ([]byte, error) {
+	urlStr := c.endpoint + path
+	if len(params) > 0 {
+		urlStr += "?" + params.Encode()
+	}
+	LogInfo(ctx, "Storage apiGet: %s", urlStr)
+	retryCount := 3
+	if c.config != nil && c.config.Retry != nil && *c.config.Retry > 0 {
+		retryCount = *c.config.Retry
+	}
+	emptyHash := sha256.Sum256(nil)
+	reqFactory := func() *http.Request {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, urlStr, nil)
+		req.Header.Set("X-Amz-Content-Sha256", hex.EncodeToString(emptyHash[:]))
+		if c.config != nil && c.config.UserAgent != nil {
+			ua := string(*c.config.UserAgent)
+			ApplyRequestOptions(req, &ua, nil)
+		}
+		signRequestV4(req, nil, c.creds, staticKeyRegion, "s3", time.Now())
+		return req
+	}
+	resp, err := DoWithRetry(ctx, c.http, reqFactory, retryCount, int64(c.http.Timeout.Seconds()))
+	if err != nil {
+		LogError(ctx, "Storage GET request failed: %v", err)
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body = io.NopCloser(bytes.NewBuffer(body))
+	if err := HandleHTTPError(resp); err != nil {
+		LogError(ctx, "Storage GET HTTP error: %v", err)
+		return nil, err
+	}
+	return body, nil
+}
+
+func (c *yandexStorageClient) ListStorageObjects(ctx context.Context, bucket string, prefix string, continuationToken string) ([]*StorageObject, string, error) {
+	params := url.Values{}
+	params.Set("list-type", "2")
+	if prefix != "" {
+		params.Set("prefix", prefix)
+	}
+	if continuationToken != "" {
+		params.Set("continuation-token", continuationToken)
+	}
+	body, err := c.apiGet(ctx, "/"+sigV4Escape(bucket), params)
+	if err != nil {
+		return nil, "", err
+	}
+	var respBody storageListObjectsResponse
+	if err := xml.Unmarshal(body, &respBody); err != nil {
+		LogError(ctx, "Storage ListObjects: failed to decode response: %v", err)
+		return nil, "", err
+	}
+	if !respBody.IsTruncated {
+		return respBody.Contents, "", nil
+	}
+	return respBody.Contents, respBody.NextContinuationToken, nil
+}
+
+// GetStorageObject returns the content of an object, decompressing gzip-encoded objects.
+func (c *yandexStorageClient) GetStorageObject(ctx context.Context, bucket string, key string) ([]byte, error) {
+	var escaped []string
+	for _, part := range strings.Split(key, "/") {
+		escaped = append(escaped, sigV4Escape(part))
+	}
+	body, err := c.apiGet(ctx, "/"+sigV4Escape(bucket)+"/"+strings.Join(escaped, "/"), nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > 2 && body[0] == 0x1f && body[1] == 0x8b {
+		zr, err := gzip.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+		return io.ReadAll(zr)
+	}
+	return body, nil
+}
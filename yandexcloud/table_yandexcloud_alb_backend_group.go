@@ -0,0 +1,218 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+func tableYandexALBBackendGroup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_alb_backend_group",
+		Description: "Yandex Cloud application load balancer backend groups.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "backend_group_id", "name"}),
+			Hydrate:    listYandexALBBackendGroups,
+		},
+		Columns: []*plugin.Column{
+			{Name: "backend_group_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "Backend group ID."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId"), Description: "Folder ID containing the backend group."},
+			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name"), Description: "Backend group name."},
+			{Name: "description", Type: proto.ColumnType_STRING, Transform: transform.FromField("Description"), Description: "Backend group description."},
+			{Name: "created_at", Type: proto.ColumnType_STRING, Transform: transform.From(createdAtALBBackendGroupDateTransform), Description: "Backend group creation date (YYYY-MM-DD)."},
+			{Name: "labels", Type: proto.ColumnType_JSON, Transform: transform.FromField("Labels"), Description: "Resource labels as key:value pairs."},
+			{Name: "type", Type: proto.ColumnType_STRING, Transform: transform.From(albBackendGroupTypeTransform), Description: "Backend group type (HTTP/GRPC/STREAM)."},
+			{Name: "backends", Type: proto.ColumnType_JSON, Transform: transform.From(albBackendGroupBackendsTransform), Description: "Backends with their ports, weights, TLS settings and health checks."},
+			{Name: "target_group_ids", Type: proto.ColumnType_JSON, Transform: transform.From(albBackendGroupTargetGroupIDsTransform), Description: "IDs of the target groups used by the backends."},
+			{Name: "storage_buckets", Type: proto.ColumnType_JSON, Transform: transform.From(albBackendGroupStorageBucketsTransform), Description: "Object Storage buckets used by the backends."},
+			{Name: "health_checks", Type: proto.ColumnType_JSON, Transform: transform.From(albBackendGroupHealthChecksTransform), Description: "Health checks of all backends."},
+			{Name: "session_affinity", Type: proto.ColumnType_JSON, Transform: transform.From(albBackendGroupSessionAffinityTransform), Description: "Session affinity settings (connection, header or cookie)."},
+		},
+	}
+}
+
+func listYandexALBBackendGroups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewALBClient(tok, 30, cfg)
+
+	var folderIDStr *string
+	if cfg.FolderID != nil {
+		str := string(*cfg.FolderID)
+		folderIDStr = &str
+	}
+	folderID := getQualString(d, "folder_id", folderIDStr)
+	if folderID == "" {
+		return nil, fmt.Errorf("folder_id must be provided")
+	}
+
+	var filters []string
+	if id := getQualString(d, "backend_group_id", nil); id != "" {
+		filters = append(filters, fmt.Sprintf("(id = \"%s\")", id))
+	}
+	if n := getQualString(d, "name", nil); n != "" {
+		filters = append(filters, fmt.Sprintf("(name = \"%s\")", n))
+	}
+
+	pageToken := ""
+	pageSize := int64(1000)
+	for {
+		groups, nextPageToken, err := client.ListALBBackendGroups(ctx, folderID, pageToken, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, bg := range groups {
+			if len(filters) > 0 {
+				if !albBackendGroupMatchesFilters(bg, filters) {
+					continue
+				}
+			}
+			d.StreamListItem(ctx, bg)
+		}
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return nil, nil
+}
+
+// Manual filtering, since the API does not support filters except folderId
+func albBackendGroupMatchesFilters(bg *ALBBackendGroup, filters []string) bool {
+	for _, f := range filters {
+		if strings.HasPrefix(f, "(id = ") && !strings.Contains(f, bg.Id) {
+			return false
+		}
+		if strings.HasPrefix(f, "(name = ") && !strings.Contains(f, bg.Name) {
+			return false
+		}
+	}
+	return true
+}
+
+// albBackendGroupList returns the type and backend list of a backend group; exactly one of them is set by the API.
+func albBackendGroupList(bg *ALBBackendGroup) (string, *ALBBackendList) {
+	switch {
+	case bg.Http != nil:
+		return "HTTP", bg.Http
+	case bg.Grpc != nil:
+		return "GRPC", bg.Grpc
+	case bg.Stream != nil:
+		return "STREAM", bg.Stream
+	}
+	return "", nil
+}
+
+func albBackendGroupTypeTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	bg, ok := d.HydrateItem.(*ALBBackendGroup)
+	if !ok {
+		return nil, nil
+	}
+	t, _ := albBackendGroupList(bg)
+	if t == "" {
+		return nil, nil
+	}
+	return t, nil
+}
+
+func albBackendGroupBackendsTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	bg, ok := d.HydrateItem.(*ALBBackendGroup)
+	if !ok {
+		return nil, nil
+	}
+	if _, list := albBackendGroupList(bg); list != nil {
+		return list.Backends, nil
+	}
+	return nil, nil
+}
+
+func albBackendGroupTargetGroupIDsTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	bg, ok := d.HydrateItem.(*ALBBackendGroup)
+	if !ok {
+		return nil, nil
+	}
+	ids := []string{}
+	if _, list := albBackendGroupList(bg); list != nil {
+		for _, b := range list.Backends {
+			if b.TargetGroups == nil {
+				continue
+			}
+			for _, id := range b.TargetGroups.TargetGroupIds {
+				ids = appendUniqueString(ids, id)
+			}
+		}
+	}
+	return ids, nil
+}
+
+func albBackendGroupStorageBucketsTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	bg, ok := d.HydrateItem.(*ALBBackendGroup)
+	if !ok {
+		return nil, nil
+	}
+	buckets := []string{}
+	if _, list := albBackendGroupList(bg); list != nil {
+		for _, b := range list.Backends {
+			if b.StorageBucket != nil && b.StorageBucket.Bucket != "" {
+				buckets = appendUniqueString(buckets, b.StorageBucket.Bucket)
+			}
+		}
+	}
+	return buckets, nil
+}
+
+func albBackendGroupHealthChecksTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	bg, ok := d.HydrateItem.(*ALBBackendGroup)
+	if !ok {
+		return nil, nil
+	}
+	checks := []map[string]interface{}{}
+	if _, list := albBackendGroupList(bg); list != nil {
+		for _, b := range list.Backends {
+			checks = append(checks, b.Healthchecks...)
+		}
+	}
+	return checks, nil
+}
+
+func albBackendGroupSessionAffinityTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	bg, ok := d.HydrateItem.(*ALBBackendGroup)
+	if !ok {
+		return nil, nil
+	}
+	_, list := albBackendGroupList(bg)
+	if list == nil {
+		return nil, nil
+	}
+	switch {
+	case list.Connection != nil:
+		return map[string]interface{}{"connection": list.Connection}, nil
+	case list.Header != nil:
+		return map[string]interface{}{"header": list.Header}, nil
+	case list.Cookie != nil:
+		return map[string]interface{}{"cookie": list.Cookie}, nil
+	}
+	return nil, nil
+}
+
+// Transform function for created_at: returns only the date (YYYY-MM-DD)
+func createdAtALBBackendGroupDateTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if d.HydrateItem == nil {
+		return nil, nil
+	}
+	bg, ok := d.HydrateItem.(*ALBBackendGroup)
+	if !ok || bg.CreatedAt == "" {
+		return nil, nil
+	}
+	if len(bg.CreatedAt) < 10 {
+		return bg.CreatedAt, nil
+	}
+	return bg.CreatedAt[:10], nil
+}
@@ -0,0 +1,109 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+func tableYandexALBHttpRouter(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_alb_http_router",
+		Description: "Yandex Cloud application load balancer HTTP routers.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "http_router_id", "name"}),
+			Hydrate:    listYandexALBHttpRouters,
+		},
+		Columns: []*plugin.Column{
+			{Name: "http_router_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "HTTP router ID."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId"), Description: "Folder ID containing the HTTP router."},
+			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name"), Description: "HTTP router name."},
+			{Name: "description", Type: proto.ColumnType_STRING, Transform: transform.FromField("Description"), Description: "HTTP router description."},
+			{Name: "created_at", Type: proto.ColumnType_STRING, Transform: transform.From(createdAtALBHttpRouterDateTransform), Description: "HTTP router creation date (YYYY-MM-DD)."},
+			{Name: "labels", Type: proto.ColumnType_JSON, Transform: transform.FromField("Labels"), Description: "Resource labels as key:value pairs."},
+			{Name: "virtual_hosts", Type: proto.ColumnType_JSON, Transform: transform.FromField("VirtualHosts"), Description: "Virtual hosts of the router with their routes."},
+			{Name: "route_options", Type: proto.ColumnType_JSON, Transform: transform.FromField("RouteOptions"), Description: "Route options applied to all virtual hosts."},
+		},
+	}
+}
+
+func listYandexALBHttpRouters(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewALBClient(tok, 30, cfg)
+
+	var folderIDStr *string
+	if cfg.FolderID != nil {
+		str := string(*cfg.FolderID)
+		folderIDStr = &str
+	}
+	folderID := getQualString(d, "folder_id", folderIDStr)
+	if folderID == "" {
+		return nil, fmt.Errorf("folder_id must be provided")
+	}
+
+	var filters []string
+	if id := getQualString(d, "http_router_id", nil); id != "" {
+		filters = append(filters, fmt.Sprintf("(id = \"%s\")", id))
+	}
+	if n := getQualString(d, "name", nil); n != "" {
+		filters = append(filters, fmt.Sprintf("(name = \"%s\")", n))
+	}
+
+	pageToken := ""
+	pageSize := int64(1000)
+	for {
+		routers, nextPageToken, err := client.ListALBHttpRouters(ctx, folderID, pageToken, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, router := range routers {
+			if len(filters) > 0 {
+				if !albHttpRouterMatchesFilters(router, filters) {
+					continue
+				}
+			}
+			d.StreamListItem(ctx, router)
+		}
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return nil, nil
+}
+
+// Manual filtering, since the API does not support filters except folderId
+func albHttpRouterMatchesFilters(router *ALBHttpRouter, filters []string) bool {
+	for _, f := range filters {
+		if strings.HasPrefix(f, "(id = ") && !strings.Contains(f, router.Id) {
+			return false
+		}
+		if strings.HasPrefix(f, "(name = ") && !strings.Contains(f, router.Name) {
+			return false
+		}
+	}
+	return true
+}
+
+// Transform function for created_at: returns only the date (YYYY-MM-DD)
+func createdAtALBHttpRouterDateTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if d.HydrateItem == nil {
+		return nil, nil
+	}
+	router, ok := d.HydrateItem.(*ALBHttpRouter)
+	if !ok || router.CreatedAt == "" {
+		return nil, nil
+	}
+	if len(router.CreatedAt) < 10 {
+		return router.CreatedAt, nil
+	}
+	return router.CreatedAt[:10], nil
+}
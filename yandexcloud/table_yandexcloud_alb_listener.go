@@ -0,0 +1,185 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// ALBListenerRow is a listener of an application load balancer with its handlers resolved.
+type ALBListenerRow struct {
+	LoadBalancerId      string
+	LoadBalancerName    string
+	FolderId            string
+	Name                string
+	Type                string
+	Addresses           []string
+	Ports               []int64
+	HttpToHttpsRedirect bool
+	HttpRouterIds       []string
+	BackendGroupIds     []string
+	CertificateIds      []string
+	SniServerNames      []string
+	Endpoints           []ALBEndpoint
+}
+
+const (
+	ALBListenerTypeHttp   = "HTTP"
+	ALBListenerTypeTls    = "TLS"
+	ALBListenerTypeStream = "STREAM"
+)
+
+func tableYandexALBListener(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_alb_listener",
+		Description: "Yandex Cloud application load balancer listeners, one row per listener.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "load_balancer_id", "type"}),
+			Hydrate:    listYandexALBListeners,
+		},
+		Columns: []*plugin.Column{
+			{Name: "load_balancer_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("LoadBalancerId"), Description: "Application load balancer ID."},
+			{Name: "load_balancer_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("LoadBalancerName"), Description: "Application load balancer name."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId"), Description: "Folder ID containing the load balancer."},
+			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name"), Description: "Listener name."},
+			{Name: "type", Type: proto.ColumnType_STRING, Transform: transform.FromField("Type"), Description: "Listener type (HTTP/TLS/STREAM)."},
+			{Name: "addresses", Type: proto.ColumnType_JSON, Transform: transform.FromField("Addresses"), Description: "IP addresses the listener accepts traffic on."},
+			{Name: "ports", Type: proto.ColumnType_JSON, Transform: transform.FromField("Ports"), Description: "Ports the listener accepts traffic on."},
+			{Name: "http_to_https_redirect", Type: proto.ColumnType_BOOL, Transform: transform.FromField("HttpToHttpsRedirect"), Description: "Whether an HTTP listener redirects all requests to HTTPS."},
+			{Name: "http_router_ids", Type: proto.ColumnType_JSON, Transform: transform.FromField("HttpRouterIds"), Description: "IDs of the HTTP routers handling the traffic."},
+			{Name: "backend_group_ids", Type: proto.ColumnType_JSON, Transform: transform.FromField("BackendGroupIds"), Description: "IDs of the stream backend groups handling the traffic."},
+			{Name: "certificate_ids", Type: proto.ColumnType_JSON, Transform: transform.FromField("CertificateIds"), Description: "IDs of the Certificate Manager certificates of a TLS listener."},
+			{Name: "sni_server_names", Type: proto.ColumnType_JSON, Transform: transform.FromField("SniServerNames"), Description: "Server names matched by the SNI handlers of a TLS listener."},
+			{Name: "endpoints", Type: proto.ColumnType_JSON, Transform: transform.FromField("Endpoints"), Description: "Raw listener endpoints."},
+		},
+	}
+}
+
+func listYandexALBListeners(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewALBClient(tok, 30, cfg)
+
+	var folderIDStr *string
+	if cfg.FolderID != nil {
+		str := string(*cfg.FolderID)
+		folderIDStr = &str
+	}
+	folderID := getQualString(d, "folder_id", folderIDStr)
+	if folderID == "" {
+		return nil, fmt.Errorf("folder_id must be provided")
+	}
+	lbID := getQualString(d, "load_balancer_id", nil)
+	listenerType := getQualString(d, "type", nil)
+
+	pageToken := ""
+	pageSize := int64(1000)
+	for {
+		balancers, nextPageToken, err := client.ListALBLoadBalancers(ctx, folderID, pageToken, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, lb := range balancers {
+			if lbID != "" && lb.Id != lbID {
+				continue
+			}
+			for _, row := range albListenerRows(lb) {
+				if listenerType != "" && row.Type != listenerType {
+					continue
+				}
+				d.StreamListItem(ctx, row)
+			}
+		}
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return nil, nil
+}
+
+func albListenerRows(lb *ALBLoadBalancer) []*ALBListenerRow {
+	rows := make([]*ALBListenerRow, 0, len(lb.Listeners))
+	for _, l := range lb.Listeners {
+		row := &ALBListenerRow{
+			LoadBalancerId:   lb.Id,
+			LoadBalancerName: lb.Name,
+			FolderId:         lb.FolderId,
+			Name:             l.Name,
+			Addresses:        []string{},
+			Ports:            []int64{},
+			HttpRouterIds:    []string{},
+			BackendGroupIds:  []string{},
+			CertificateIds:   []string{},
+			SniServerNames:   []string{},
+			Endpoints:        l.Endpoints,
+		}
+		for _, ep := range l.Endpoints {
+			for _, addr := range ep.Addresses {
+				for _, v := range []*ALBAddressValue{addr.ExternalIpv4Address, addr.InternalIpv4Address, addr.ExternalIpv6Address} {
+					if v != nil && v.Address != "" {
+						row.Addresses = appendUniqueString(row.Addresses, v.Address)
+					}
+				}
+			}
+			for _, p := range ep.Ports {
+				if port, err := p.Int64(); err == nil {
+					row.Ports = append(row.Ports, port)
+				}
+			}
+		}
+		switch {
+		case l.Http != nil:
+			row.Type = ALBListenerTypeHttp
+			if l.Http.Redirects != nil {
+				row.HttpToHttpsRedirect = l.Http.Redirects.HttpToHttps
+			}
+			if l.Http.Handler != nil && l.Http.Handler.HttpRouterId != "" {
+				row.HttpRouterIds = appendUniqueString(row.HttpRouterIds, l.Http.Handler.HttpRouterId)
+			}
+		case l.Tls != nil:
+			row.Type = ALBListenerTypeTls
+			handlers := []*ALBTlsHandler{l.Tls.DefaultHandler}
+			for i := range l.Tls.SniHandlers {
+				handlers = append(handlers, &l.Tls.SniHandlers[i].Handler)
+				row.SniServerNames = append(row.SniServerNames, l.Tls.SniHandlers[i].ServerNames...)
+			}
+			for _, h := range handlers {
+				if h == nil {
+					continue
+				}
+				for _, id := range h.CertificateIds {
+					row.CertificateIds = appendUniqueString(row.CertificateIds, id)
+				}
+				if h.HttpHandler != nil && h.HttpHandler.HttpRouterId != "" {
+					row.HttpRouterIds = appendUniqueString(row.HttpRouterIds, h.HttpHandler.HttpRouterId)
+				}
+				if h.StreamHandler != nil && h.StreamHandler.BackendGroupId != "" {
+					row.BackendGroupIds = appendUniqueString(row.BackendGroupIds, h.StreamHandler.BackendGroupId)
+				}
+			}
+		case l.Stream != nil:
+			row.Type = ALBListenerTypeStream
+			if l.Stream.Handler != nil && l.Stream.Handler.BackendGroupId != "" {
+				row.BackendGroupIds = appendUniqueString(row.BackendGroupIds, l.Stream.Handler.BackendGroupId)
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func appendUniqueString(list []string, s string) []string {
+	for _, v := range list {
+		if v == s {
+			return list
+		}
+	}
+	return append(list, s)
+}
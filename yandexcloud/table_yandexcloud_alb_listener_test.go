@@ -0,0 +1,58 @@
+package yandexcloud
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestALBListenerRows(t *testing.T) {
+	raw := `{
+		"id": "lb1",
+		"name": "public",
+		"folderId": "f1",
+		"listeners": [
+			{
+				"name": "http",
+				"endpoints": [{"addresses": [{"externalIpv4Address": {"address": "1.2.3.4"}}], "ports": ["80"]}],
+				"http": {"redirects": {"httpToHttps": true}}
+			},
+			{
+				"name": "https",
+				"endpoints": [{"addresses": [{"externalIpv4Address": {"address": "1.2.3.4"}}, {"externalIpv6Address": {"address": "2a02::1"}}], "ports": ["443", "8443"]}],
+				"tls": {
+					"defaultHandler": {"httpHandler": {"httpRouterId": "r1"}, "certificateIds": ["c1"]},
+					"sniHandlers": [{"name": "api", "serverNames": ["api.example.com"], "handler": {"httpHandler": {"httpRouterId": "r2"}, "certificateIds": ["c2", "c1"]}}]
+				}
+			},
+			{
+				"name": "tcp",
+				"endpoints": [{"addresses": [{"internalIpv4Address": {"address": "10.0.0.5", "subnetId": "s1"}}], "ports": ["5432"]}],
+				"stream": {"handler": {"backendGroupId": "bg1"}}
+			}
+		]
+	}`
+	var lb ALBLoadBalancer
+	if err := json.Unmarshal([]byte(raw), &lb); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rows := albListenerRows(&lb)
+	if len(rows) != 3 {
+		t.Fatalf("expected 3 rows, got %d", len(rows))
+	}
+	if rows[0].Type != ALBListenerTypeHttp || !rows[0].HttpToHttpsRedirect || len(rows[0].Ports) != 1 || rows[0].Ports[0] != 80 {
+		t.Errorf("unexpected http row: %+v", rows[0])
+	}
+	tls := rows[1]
+	if tls.Type != ALBListenerTypeTls || len(tls.Addresses) != 2 || len(tls.Ports) != 2 {
+		t.Errorf("unexpected tls row: %+v", tls)
+	}
+	if len(tls.CertificateIds) != 2 || tls.CertificateIds[0] != "c1" || tls.CertificateIds[1] != "c2" {
+		t.Errorf("unexpected certificate ids: %v", tls.CertificateIds)
+	}
+	if len(tls.HttpRouterIds) != 2 || len(tls.SniServerNames) != 1 {
+		t.Errorf("unexpected tls handlers: %+v", tls)
+	}
+	if rows[2].Type != ALBListenerTypeStream || len(rows[2].BackendGroupIds) != 1 || rows[2].Addresses[0] != "10.0.0.5" {
+		t.Errorf("unexpected stream row: %+v", rows[2])
+	}
+}
@@ -0,0 +1,160 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+func tableYandexALBLoadBalancer(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_alb_load_balancer",
+		Description: "Yandex Cloud application load balancers.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "load_balancer_id", "name", "network_id", "status"}),
+			Hydrate:    listYandexALBLoadBalancers,
+		},
+		Columns: []*plugin.Column{
+			{Name: "load_balancer_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "Application load balancer ID."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId"), Description: "Folder ID containing the load balancer."},
+			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name"), Description: "Load balancer name."},
+			{Name: "description", Type: proto.ColumnType_STRING, Transform: transform.FromField("Description"), Description: "Load balancer description."},
+			{Name: "created_at", Type: proto.ColumnType_STRING, Transform: transform.From(createdAtALBLoadBalancerDateTransform), Description: "Load balancer creation date (YYYY-MM-DD)."},
+			{Name: "labels", Type: proto.ColumnType_JSON, Transform: transform.FromField("Labels"), Description: "Resource labels as key:value pairs."},
+			{Name: "status", Type: proto.ColumnType_STRING, Transform: transform.FromField("Status"), Description: "Load balancer status, e.g. ACTIVE or STOPPED."},
+			{Name: "region_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("RegionId"), Description: "Region of the load balancer."},
+			{Name: "network_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("NetworkId"), Description: "Network ID of the load balancer."},
+			{Name: "security_group_ids", Type: proto.ColumnType_JSON, Transform: transform.FromField("SecurityGroupIds"), Description: "IDs of the security groups attached to the load balancer."},
+			{Name: "allocation_locations", Type: proto.ColumnType_JSON, Transform: transform.FromField("AllocationPolicy.Locations"), Description: "Zones and subnets the load balancer is allocated in."},
+			{Name: "log_group_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("LogGroupId").Transform(transform.NullIfZeroValue), Description: "ID of the Cloud Logging group for the load balancer logs."},
+			{Name: "listeners", Type: proto.ColumnType_JSON, Transform: transform.FromField("Listeners"), Description: "Raw listener specifications."},
+			{Name: "certificate_ids", Type: proto.ColumnType_JSON, Transform: transform.From(albLoadBalancerCertificateIDsTransform), Description: "IDs of the certificates used by the TLS listeners."},
+			{Name: "http_router_ids", Type: proto.ColumnType_JSON, Transform: transform.From(albLoadBalancerHttpRouterIDsTransform), Description: "IDs of the HTTP routers used by the listeners."},
+			{Name: "auto_scale_policy", Type: proto.ColumnType_JSON, Transform: transform.FromField("AutoScalePolicy"), Description: "Resource unit scaling settings."},
+			{Name: "log_options", Type: proto.ColumnType_JSON, Transform: transform.FromField("LogOptions"), Description: "Cloud Logging settings."},
+		},
+	}
+}
+
+func listYandexALBLoadBalancers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewALBClient(tok, 30, cfg)
+
+	var folderIDStr *string
+	if cfg.FolderID != nil {
+		str := string(*cfg.FolderID)
+		folderIDStr = &str
+	}
+	folderID := getQualString(d, "folder_id", folderIDStr)
+	if folderID == "" {
+		return nil, fmt.Errorf("folder_id must be provided")
+	}
+
+	var filters []string
+	if id := getQualString(d, "load_balancer_id", nil); id != "" {
+		filters = append(filters, fmt.Sprintf("(id = \"%s\")", id))
+	}
+	if n := getQualString(d, "name", nil); n != "" {
+		filters = append(filters, fmt.Sprintf("(name = \"%s\")", n))
+	}
+	if netid := getQualString(d, "network_id", nil); netid != "" {
+		filters = append(filters, fmt.Sprintf("(networkId = \"%s\")", netid))
+	}
+	if st := getQualString(d, "status", nil); st != "" {
+		filters = append(filters, fmt.Sprintf("(status = \"%s\")", st))
+	}
+
+	pageToken := ""
+	pageSize := int64(1000)
+	for {
+		balancers, nextPageToken, err := client.ListALBLoadBalancers(ctx, folderID, pageToken, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, lb := range balancers {
+			if len(filters) > 0 {
+				if !albLoadBalancerMatchesFilters(lb, filters) {
+					continue
+				}
+			}
+			d.StreamListItem(ctx, lb)
+		}
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return nil, nil
+}
+
+// Manual filtering, since the API does not support filters except folderId
+func albLoadBalancerMatchesFilters(lb *ALBLoadBalancer, filters []string) bool {
+	for _, f := range filters {
+		if strings.HasPrefix(f, "(id = ") && !strings.Contains(f, lb.Id) {
+			return false
+		}
+		if strings.HasPrefix(f, "(name = ") && !strings.Contains(f, lb.Name) {
+			return false
+		}
+		if strings.HasPrefix(f, "(networkId = ") && !strings.Contains(f, lb.NetworkId) {
+			return false
+		}
+		if strings.HasPrefix(f, "(status = ") && !strings.Contains(f, lb.Status) {
+			return false
+		}
+	}
+	return true
+}
+
+// Transform function for certificate_ids: certificates of all TLS listeners
+func albLoadBalancerCertificateIDsTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	lb, ok := d.HydrateItem.(*ALBLoadBalancer)
+	if !ok {
+		return nil, nil
+	}
+	ids := []string{}
+	for _, row := range albListenerRows(lb) {
+		for _, id := range row.CertificateIds {
+			ids = appendUniqueString(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// Transform function for http_router_ids: HTTP routers of all listeners
+func albLoadBalancerHttpRouterIDsTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	lb, ok := d.HydrateItem.(*ALBLoadBalancer)
+	if !ok {
+		return nil, nil
+	}
+	ids := []string{}
+	for _, row := range albListenerRows(lb) {
+		for _, id := range row.HttpRouterIds {
+			ids = appendUniqueString(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// Transform function for created_at: returns only the date (YYYY-MM-DD)
+func createdAtALBLoadBalancerDateTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if d.HydrateItem == nil {
+		return nil, nil
+	}
+	lb, ok := d.HydrateItem.(*ALBLoadBalancer)
+	if !ok || lb.CreatedAt == "" {
+		return nil, nil
+	}
+	if len(lb.CreatedAt) < 10 {
+		return lb.CreatedAt, nil
+	}
+	return lb.CreatedAt[:10], nil
+}
@@ -0,0 +1,182 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// ALBRouteRow is a route of a virtual host with its match and action resolved.
+type ALBRouteRow struct {
+	HttpRouterId    string
+	HttpRouterName  string
+	FolderId        string
+	VirtualHostName string
+	Authority       []string
+	Name            string
+	Type            string
+	Action          string
+	MatchType       string
+	MatchValue      string
+	HttpMethods     []string
+	BackendGroupId  string
+	Timeout         string
+	Redirect        map[string]interface{}
+	Response        map[string]interface{}
+}
+
+const (
+	ALBRouteTypeHttp = "HTTP"
+	ALBRouteTypeGrpc = "GRPC"
+
+	ALBRouteActionRoute          = "ROUTE"
+	ALBRouteActionRedirect       = "REDIRECT"
+	ALBRouteActionDirectResponse = "DIRECT_RESPONSE"
+	ALBRouteActionStatusResponse = "STATUS_RESPONSE"
+)
+
+func tableYandexALBRoute(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_alb_route",
+		Description: "Yandex Cloud application load balancer routes, one row per virtual host route.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "http_router_id", "virtual_host_name", "backend_group_id"}),
+			Hydrate:    listYandexALBRoutes,
+		},
+		Columns: []*plugin.Column{
+			{Name: "http_router_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("HttpRouterId"), Description: "HTTP router ID."},
+			{Name: "http_router_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("HttpRouterName"), Description: "HTTP router name."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId"), Description: "Folder ID containing the HTTP router."},
+			{Name: "virtual_host_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("VirtualHostName"), Description: "Name of the virtual host of the route."},
+			{Name: "authority", Type: proto.ColumnType_JSON, Transform: transform.FromField("Authority"), Description: "Domains served by the virtual host of the route."},
+			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name"), Description: "Route name."},
+			{Name: "type", Type: proto.ColumnType_STRING, Transform: transform.FromField("Type"), Description: "Route type (HTTP/GRPC)."},
+			{Name: "action", Type: proto.ColumnType_STRING, Transform: transform.FromField("Action"), Description: "Route action (ROUTE/REDIRECT/DIRECT_RESPONSE/STATUS_RESPONSE)."},
+			{Name: "match_type", Type: proto.ColumnType_STRING, Transform: transform.FromField("MatchType").Transform(transform.NullIfZeroValue), Description: "Type of the path (HTTP) or FQMN (gRPC) match (EXACT/PREFIX/REGEX)."},
+			{Name: "match_value", Type: proto.ColumnType_STRING, Transform: transform.FromField("MatchValue").Transform(transform.NullIfZeroValue), Description: "Path (HTTP) or FQMN (gRPC) the route matches."},
+			{Name: "http_methods", Type: proto.ColumnType_JSON, Transform: transform.FromField("HttpMethods"), Description: "HTTP methods the route matches; empty matches all."},
+			{Name: "backend_group_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("BackendGroupId").Transform(transform.NullIfZeroValue), Description: "ID of the backend group receiving the traffic."},
+			{Name: "timeout", Type: proto.ColumnType_STRING, Transform: transform.FromField("Timeout").Transform(transform.NullIfZeroValue), Description: "Overall timeout of a request to the backend."},
+			{Name: "redirect", Type: proto.ColumnType_JSON, Transform: transform.FromField("Redirect"), Description: "Redirect settings of a REDIRECT route."},
+			{Name: "response", Type: proto.ColumnType_JSON, Transform: transform.FromField("Response"), Description: "Response of a DIRECT_RESPONSE or STATUS_RESPONSE route."},
+		},
+	}
+}
+
+func listYandexALBRoutes(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewALBClient(tok, 30, cfg)
+
+	var folderIDStr *string
+	if cfg.FolderID != nil {
+		str := string(*cfg.FolderID)
+		folderIDStr = &str
+	}
+	folderID := getQualString(d, "folder_id", folderIDStr)
+	if folderID == "" {
+		return nil, fmt.Errorf("folder_id must be provided")
+	}
+	routerID := getQualString(d, "http_router_id", nil)
+	vhName := getQualString(d, "virtual_host_name", nil)
+	backendGroupID := getQualString(d, "backend_group_id", nil)
+
+	pageToken := ""
+	pageSize := int64(1000)
+	for {
+		routers, nextPageToken, err := client.ListALBHttpRouters(ctx, folderID, pageToken, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, router := range routers {
+			if routerID != "" && router.Id != routerID {
+				continue
+			}
+			for _, row := range albRouteRows(router) {
+				if vhName != "" && row.VirtualHostName != vhName {
+					continue
+				}
+				if backendGroupID != "" && row.BackendGroupId != backendGroupID {
+					continue
+				}
+				d.StreamListItem(ctx, row)
+			}
+		}
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return nil, nil
+}
+
+func albRouteRows(router *ALBHttpRouter) []*ALBRouteRow {
+	var rows []*ALBRouteRow
+	for _, vh := range router.VirtualHosts {
+		for _, r := range vh.Routes {
+			row := &ALBRouteRow{
+				HttpRouterId:    router.Id,
+				HttpRouterName:  router.Name,
+				FolderId:        router.FolderId,
+				VirtualHostName: vh.Name,
+				Authority:       vh.Authority,
+				Name:            r.Name,
+				HttpMethods:     []string{},
+			}
+			var match *ALBStringMatch
+			var action *ALBRouteAction
+			switch {
+			case r.Http != nil:
+				row.Type = ALBRouteTypeHttp
+				if r.Http.Match != nil {
+					match = r.Http.Match.Path
+					if r.Http.Match.HttpMethod != nil {
+						row.HttpMethods = r.Http.Match.HttpMethod
+					}
+				}
+				action = r.Http.Route
+				switch {
+				case r.Http.Redirect != nil:
+					row.Action = ALBRouteActionRedirect
+					row.Redirect = r.Http.Redirect
+				case r.Http.DirectResponse != nil:
+					row.Action = ALBRouteActionDirectResponse
+					row.Response = r.Http.DirectResponse
+				}
+			case r.Grpc != nil:
+				row.Type = ALBRouteTypeGrpc
+				if r.Grpc.Match != nil {
+					match = r.Grpc.Match.Fqmn
+				}
+				action = r.Grpc.Route
+				if r.Grpc.StatusResponse != nil {
+					row.Action = ALBRouteActionStatusResponse
+					row.Response = r.Grpc.StatusResponse
+				}
+			}
+			if action != nil {
+				row.Action = ALBRouteActionRoute
+				row.BackendGroupId = action.BackendGroupId
+				row.Timeout = action.Timeout
+			}
+			if match != nil {
+				switch {
+				case match.ExactMatch != "":
+					row.MatchType, row.MatchValue = "EXACT", match.ExactMatch
+				case match.PrefixMatch != "":
+					row.MatchType, row.MatchValue = "PREFIX", match.PrefixMatch
+				case match.RegexMatch != "":
+					row.MatchType, row.MatchValue = "REGEX", match.RegexMatch
+				}
+			}
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
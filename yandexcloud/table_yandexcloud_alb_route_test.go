@@ -0,0 +1,41 @@
+package yandexcloud
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestALBRouteRows(t *testing.T) {
+	raw := `{
+		"id": "r1",
+		"name": "main",
+		"virtualHosts": [
+			{
+				"name": "web",
+				"authority": ["example.com"],
+				"routes": [
+					{"name": "api", "http": {"match": {"httpMethod": ["GET"], "path": {"prefixMatch": "/api"}}, "route": {"backendGroupId": "bg1", "timeout": "60s"}}},
+					{"name": "old", "http": {"match": {"path": {"exactMatch": "/old"}}, "redirect": {"replacePath": "/new"}}},
+					{"name": "rpc", "grpc": {"match": {"fqmn": {"prefixMatch": "/svc"}}, "route": {"backendGroupId": "bg2"}}}
+				]
+			}
+		]
+	}`
+	var router ALBHttpRouter
+	if err := json.Unmarshal([]byte(raw), &router); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rows := albRouteRows(&router)
+	if len(rows) != 3 {
+		t.Fatalf("expected 3 rows, got %d", len(rows))
+	}
+	if r := rows[0]; r.Action != ALBRouteActionRoute || r.BackendGroupId != "bg1" || r.MatchType != "PREFIX" || r.MatchValue != "/api" || len(r.HttpMethods) != 1 {
+		t.Errorf("unexpected route row: %+v", r)
+	}
+	if r := rows[1]; r.Action != ALBRouteActionRedirect || r.BackendGroupId != "" || r.MatchType != "EXACT" {
+		t.Errorf("unexpected redirect row: %+v", r)
+	}
+	if r := rows[2]; r.Type != ALBRouteTypeGrpc || r.BackendGroupId != "bg2" || r.VirtualHostName != "web" {
+		t.Errorf("unexpected grpc row: %+v", r)
+	}
+}
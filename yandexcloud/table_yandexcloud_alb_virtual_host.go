@@ -0,0 +1,104 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// ALBVirtualHostRow is a virtual host together with the HTTP router it belongs to.
+type ALBVirtualHostRow struct {
+	HttpRouterId          string
+	HttpRouterName        string
+	FolderId              string
+	Name                  string
+	Authority             []string
+	RouteCount            int
+	Routes                []ALBRoute
+	ModifyRequestHeaders  []map[string]interface{}
+	ModifyResponseHeaders []map[string]interface{}
+	RouteOptions          map[string]interface{}
+}
+
+func tableYandexALBVirtualHost(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_alb_virtual_host",
+		Description: "Yandex Cloud application load balancer virtual hosts, one row per HTTP router virtual host.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "http_router_id", "name"}),
+			Hydrate:    listYandexALBVirtualHosts,
+		},
+		Columns: []*plugin.Column{
+			{Name: "http_router_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("HttpRouterId"), Description: "HTTP router ID."},
+			{Name: "http_router_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("HttpRouterName"), Description: "HTTP router name."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId"), Description: "Folder ID containing the HTTP router."},
+			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name"), Description: "Virtual host name."},
+			{Name: "authority", Type: proto.ColumnType_JSON, Transform: transform.FromField("Authority"), Description: "Domains (Host/:authority values) served by the virtual host."},
+			{Name: "route_count", Type: proto.ColumnType_INT, Transform: transform.FromField("RouteCount"), Description: "Number of routes of the virtual host."},
+			{Name: "routes", Type: proto.ColumnType_JSON, Transform: transform.FromField("Routes"), Description: "Raw routes of the virtual host."},
+			{Name: "modify_request_headers", Type: proto.ColumnType_JSON, Transform: transform.FromField("ModifyRequestHeaders"), Description: "Request header modifications."},
+			{Name: "modify_response_headers", Type: proto.ColumnType_JSON, Transform: transform.FromField("ModifyResponseHeaders"), Description: "Response header modifications."},
+			{Name: "route_options", Type: proto.ColumnType_JSON, Transform: transform.FromField("RouteOptions"), Description: "Route options applied to all routes of the virtual host."},
+		},
+	}
+}
+
+func listYandexALBVirtualHosts(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewALBClient(tok, 30, cfg)
+
+	var folderIDStr *string
+	if cfg.FolderID != nil {
+		str := string(*cfg.FolderID)
+		folderIDStr = &str
+	}
+	folderID := getQualString(d, "folder_id", folderIDStr)
+	if folderID == "" {
+		return nil, fmt.Errorf("folder_id must be provided")
+	}
+	routerID := getQualString(d, "http_router_id", nil)
+	name := getQualString(d, "name", nil)
+
+	pageToken := ""
+	pageSize := int64(1000)
+	for {
+		routers, nextPageToken, err := client.ListALBHttpRouters(ctx, folderID, pageToken, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, router := range routers {
+			if routerID != "" && router.Id != routerID {
+				continue
+			}
+			for _, vh := range router.VirtualHosts {
+				if name != "" && vh.Name != name {
+					continue
+				}
+				d.StreamListItem(ctx, &ALBVirtualHostRow{
+					HttpRouterId:          router.Id,
+					HttpRouterName:        router.Name,
+					FolderId:              router.FolderId,
+					Name:                  vh.Name,
+					Authority:             vh.Authority,
+					RouteCount:            len(vh.Routes),
+					Routes:                vh.Routes,
+					ModifyRequestHeaders:  vh.ModifyRequestHeaders,
+					ModifyResponseHeaders: vh.ModifyResponseHeaders,
+					RouteOptions:          vh.RouteOptions,
+				})
+			}
+		}
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return nil, nil
+}
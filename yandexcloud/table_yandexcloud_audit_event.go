@@ -0,0 +1,310 @@
+package yandexcloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// auditEventMaxDays limits the number of daily object prefixes read by one query.
+const auditEventMaxDays = 31
+
+// AuditEvent is an event in an exported Audit Trails JSON object.
+type AuditEvent struct {
+	EventId        string `json:"event_id"`
+	EventSource    string `json:"event_source"`
+	EventType      string `json:"event_type"`
+	EventTime      string `json:"event_time"`
+	EventStatus    string `json:"event_status"`
+	Authentication struct {
+		Authenticated bool   `json:"authenticated"`
+		SubjectType   string `json:"subject_type"`
+		SubjectId     string `json:"subject_id"`
+		SubjectName   string `json:"subject_name"`
+	} `json:"authentication"`
+	Authorization struct {
+		Authorized bool `json:"authorized"`
+	} `json:"authorization"`
+	ResourceMetadata struct {
+		Path []AuditEventResource `json:"path"`
+	} `json:"resource_metadata"`
+	RequestMetadata struct {
+		RemoteAddress string `json:"remote_address"`
+		UserAgent     string `json:"user_agent"`
+		RequestId     string `json:"request_id"`
+	} `json:"request_metadata"`
+	Details map[string]interface{} `json:"details"`
+	Error   map[string]interface{} `json:"error"`
+}
+
+// AuditEventResource is an element of the resource path of an audit event.
+type AuditEventResource struct {
+	ResourceType string `json:"resource_type"`
+	ResourceId   string `json:"resource_id"`
+	ResourceName string `json:"resource_name"`
+}
+
+// AuditEventRow is a flattened audit event.
+type AuditEventRow struct {
+	TrailId       string
+	ObjectKey     string
+	EventId       string
+	EventSource   string
+	EventType     string
+	EventTime     time.Time
+	EventStatus   string
+	SubjectType   string
+	SubjectId     string
+	SubjectName   string
+	Authenticated bool
+	Authorized    bool
+	CloudId       string
+	CloudName     string
+	FolderId      string
+	FolderName    string
+	ResourceType  string
+	ResourceId    string
+	ResourceName  string
+	ResourcePath  []AuditEventResource
+	RemoteAddress string
+	UserAgent     string
+	RequestId     string
+	Details       map[string]interface{}
+	Error         map[string]interface{}
+}
+
+func tableYandexAuditEvent(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_audit_event",
+		Description: "Yandex Cloud audit events exported by a trail to Object Storage.",
+		List: &plugin.ListConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "trail_id", Require: plugin.Required},
+				{Name: "event_time", Require: plugin.Optional, Operators: []string{">", ">=", "=", "<", "<="}},
+				{Name: "event_type", Require: plugin.Optional},
+				{Name: "event_source", Require: plugin.Optional},
+				{Name: "event_status", Require: plugin.Optional},
+				{Name: "subject_id", Require: plugin.Optional},
+				{Name: "resource_id", Require: plugin.Optional},
+			},
+			Hydrate: listYandexAuditEvents,
+		},
+		Columns: []*plugin.Column{
+			{Name: "trail_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("TrailId"), Description: "ID of the trail that exported the event."},
+			{Name: "event_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("EventId"), Description: "Event ID."},
+			{Name: "event_source", Type: proto.ColumnType_STRING, Transform: transform.FromField("EventSource"), Description: "Service that generated the event, e.g. compute."},
+			{Name: "event_type", Type: proto.ColumnType_STRING, Transform: transform.FromField("EventType"), Description: "Event type, e.g. yandex.cloud.audit.compute.DeleteDisk."},
+			{Name: "event_time", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("EventTime").Transform(transform.NullIfZeroValue), Description: "Time of the event."},
+			{Name: "event_status", Type: proto.ColumnType_STRING, Transform: transform.FromField("EventStatus"), Description: "Event status (STARTED/DONE/ERROR/CANCELLED)."},
+			{Name: "subject_type", Type: proto.ColumnType_STRING, Transform: transform.FromField("SubjectType").Transform(transform.NullIfZeroValue), Description: "Type of the authenticated subject, e.g. SERVICE_ACCOUNT."},
+			{Name: "subject_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("SubjectId").Transform(transform.NullIfZeroValue), Description: "ID of the subject that performed the action."},
+			{Name: "subject_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("SubjectName").Transform(transform.NullIfZeroValue), Description: "Name of the subject that performed the action."},
+			{Name: "authenticated", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Authenticated"), Description: "Whether the subject was authenticated."},
+			{Name: "authorized", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Authorized"), Description: "Whether the action was authorized."},
+			{Name: "cloud_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("CloudId").Transform(transform.NullIfZeroValue), Description: "Cloud ID of the resource."},
+			{Name: "cloud_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("CloudName").Transform(transform.NullIfZeroValue), Description: "Cloud name of the resource."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId").Transform(transform.NullIfZeroValue), Description: "Folder ID of the resource."},
+			{Name: "folder_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderName").Transform(transform.NullIfZeroValue), Description: "Folder name of the resource."},
+			{Name: "resource_type", Type: proto.ColumnType_STRING, Transform: transform.FromField("ResourceType").Transform(transform.NullIfZeroValue), Description: "Type of the affected resource derived from the event type, e.g. compute.disk."},
+			{Name: "resource_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ResourceId").Transform(transform.NullIfZeroValue), Description: "ID of the affected resource, taken from the event details."},
+			{Name: "resource_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("ResourceName").Transform(transform.NullIfZeroValue), Description: "Name of the affected resource, taken from the event details."},
+			{Name: "resource_path", Type: proto.ColumnType_JSON, Transform: transform.FromField("ResourcePath"), Description: "Resource hierarchy of the event (organization, cloud, folder)."},
+			{Name: "remote_address", Type: proto.ColumnType_IPADDR, Transform: transform.FromField("RemoteAddress").Transform(transform.NullIfZeroValue), Description: "IP address the request came from."},
+			{Name: "user_agent", Type: proto.ColumnType_STRING, Transform: transform.FromField("UserAgent").Transform(transform.NullIfZeroValue), Description: "User agent of the request."},
+			{Name: "request_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("RequestId").Transform(transform.NullIfZeroValue), Description: "Request ID."},
+			{Name: "details", Type: proto.ColumnType_JSON, Transform: transform.FromField("Details"), Description: "Event details specific to the event type."},
+			{Name: "error", Type: proto.ColumnType_JSON, Transform: transform.FromField("Error"), Description: "Error of a failed operation."},
+			{Name: "object_key", Type: proto.ColumnType_STRING, Transform: transform.FromField("ObjectKey"), Description: "Object Storage key of the exported file containing the event."},
+		},
+	}
+}
+
+func listYandexAuditEvents(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	trailID := getQualString(d, "trail_id", nil)
+	trail, err := NewAuditTrailsClient(tok, 30, cfg).GetAuditTrail(ctx, trailID)
+	if err != nil {
+		return nil, err
+	}
+	if trail.Destination.ObjectStorage == nil {
+		return nil, fmt.Errorf("trail %s does not export events to Object Storage", trailID)
+	}
+	storage, err := NewStorageClient(30, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := getQualTimeRange(d, "event_time")
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if from.IsZero() {
+		from = to.Add(-24 * time.Hour)
+	}
+	days := auditEventDays(from, to)
+	if len(days) > auditEventMaxDays {
+		return nil, fmt.Errorf("event_time range must not exceed %d days", auditEventMaxDays)
+	}
+
+	equals := map[string]string{}
+	for _, column := range []string{"event_type", "event_source", "event_status", "subject_id", "resource_id"} {
+		if v := getQualString(d, column, nil); v != "" {
+			equals[column] = v
+		}
+	}
+
+	bucket := trail.Destination.ObjectStorage.BucketId
+	for _, day := range days {
+		prefix := auditEventObjectPrefix(trail.Destination.ObjectStorage.ObjectPrefix, trailID, day)
+		token := ""
+		for {
+			objects, nextToken, err := storage.ListStorageObjects(ctx, bucket, prefix, token)
+			if err != nil {
+				return nil, err
+			}
+			for _, obj := range objects {
+				body, err := storage.GetStorageObject(ctx, bucket, obj.Key)
+				if err != nil {
+					return nil, err
+				}
+				var events []*AuditEvent
+				if err := json.Unmarshal(body, &events); err != nil {
+					LogError(ctx, "Failed to decode audit events in %s: %v", obj.Key, err)
+					continue
+				}
+				for _, ev := range events {
+					row := auditEventRow(trailID, obj.Key, ev)
+					if row.EventTime.Before(from) || row.EventTime.After(to) || !auditEventMatches(row, equals) {
+						continue
+					}
+					d.StreamListItem(ctx, row)
+					if d.QueryStatus.RowsRemaining(ctx) == 0 {
+						return nil, nil
+					}
+				}
+			}
+			if nextToken == "" {
+				break
+			}
+			token = nextToken
+		}
+	}
+	return nil, nil
+}
+
+// auditEventDays returns the UTC dates between from and to, inclusive.
+func auditEventDays(from time.Time, to time.Time) []time.Time {
+	var days []time.Time
+	day := time.Date(from.UTC().Year(), from.UTC().Month(), from.UTC().Day(), 0, 0, 0, 0, time.UTC)
+	for !day.After(to.UTC()) {
+		days = append(days, day)
+		day = day.AddDate(0, 0, 1)
+	}
+	return days
+}
+
+// auditEventObjectPrefix returns the key prefix of the objects a trail exported on a day:
+// <object_prefix>/<trail_id>/<YYYY>/<MM>/<DD>/.
+func auditEventObjectPrefix(objectPrefix string, trailID string, day time.Time) string {
+	prefix := strings.Trim(objectPrefix, "/")
+	if prefix != "" {
+		prefix += "/"
+	}
+	return prefix + trailID + "/" + day.Format("2006/01/02") + "/"
+}
+
+func auditEventMatches(row *AuditEventRow, equals map[string]string) bool {
+	values := map[string]string{
+		"event_type":   row.EventType,
+		"event_source": row.EventSource,
+		"event_status": row.EventStatus,
+		"subject_id":   row.SubjectId,
+		"resource_id":  row.ResourceId,
+	}
+	for column, v := range equals {
+		if values[column] != v {
+			return false
+		}
+	}
+	return true
+}
+
+func auditEventRow(trailID string, objectKey string, ev *AuditEvent) *AuditEventRow {
+	row := &AuditEventRow{
+		TrailId:       trailID,
+		ObjectKey:     objectKey,
+		EventId:       ev.EventId,
+		EventSource:   ev.EventSource,
+		EventType:     ev.EventType,
+		EventStatus:   ev.EventStatus,
+		SubjectType:   ev.Authentication.SubjectType,
+		SubjectId:     ev.Authentication.SubjectId,
+		SubjectName:   ev.Authentication.SubjectName,
+		Authenticated: ev.Authentication.Authenticated,
+		Authorized:    ev.Authorization.Authorized,
+		ResourcePath:  ev.ResourceMetadata.Path,
+		RemoteAddress: ev.RequestMetadata.RemoteAddress,
+		UserAgent:     ev.RequestMetadata.UserAgent,
+		RequestId:     ev.RequestMetadata.RequestId,
+		Details:       ev.Details,
+		Error:         ev.Error,
+	}
+	if t, err := time.Parse(time.RFC3339Nano, ev.EventTime); err == nil {
+		row.EventTime = t
+	}
+	for _, r := range ev.ResourceMetadata.Path {
+		switch r.ResourceType {
+		case "resource-manager.cloud":
+			row.CloudId, row.CloudName = r.ResourceId, r.ResourceName
+		case "resource-manager.folder":
+			row.FolderId, row.FolderName = r.ResourceId, r.ResourceName
+		}
+	}
+	row.ResourceType, row.ResourceId, row.ResourceName = auditEventResource(ev)
+	return row
+}
+
+// auditEventResource derives the affected resource from the event type and details. For
+// yandex.cloud.audit.compute.UpdateInstanceMetadata it tries the instance_metadata_id and
+// instance_id detail keys and returns the first one present as compute.instance.
+func auditEventResource(ev *AuditEvent) (string, string, string) {
+	parts := strings.Split(ev.EventType, ".")
+	if len(parts) < 2 || ev.Details == nil {
+		return "", "", ""
+	}
+	service, action := parts[len(parts)-2], parts[len(parts)-1]
+	// Split the action into words and drop the leading verb.
+	var words []string
+	for i, r := range action {
+		if i > 0 && unicode.IsUpper(r) {
+			words = append(words, "")
+		}
+		if len(words) == 0 {
+			words = append(words, "")
+		}
+		words[len(words)-1] += string(unicode.ToLower(r))
+	}
+	if len(words) < 2 {
+		return "", "", ""
+	}
+	words = words[1:]
+	for n := len(words); n > 0; n-- {
+		name := strings.Join(words[:n], "_")
+		if id, ok := ev.Details[name+"_id"].(string); ok && id != "" {
+			resourceName, _ := ev.Details[name+"_name"].(string)
+			return service + "." + name, id, resourceName
+		}
+	}
+	return "", "", ""
+}
@@ -0,0 +1,139 @@
+package yandexcloud
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/context_key"
+)
+
+const testAuditEvents = `[{
+	"event_id": "ev1",
+	"event_source": "compute",
+	"event_type": "yandex.cloud.audit.compute.DeleteDisk",
+	"event_time": "2024-03-05T10:15:30.123Z",
+	"event_status": "DONE",
+	"authentication": {"authenticated": true, "subject_type": "SERVICE_ACCOUNT", "subject_id": "aje1", "subject_name": "ci-bot"},
+	"authorization": {"authorized": true},
+	"resource_metadata": {"path": [
+		{"resource_type": "resource-manager.cloud", "resource_id": "b1gcloud", "resource_name": "prod"},
+		{"resource_type": "resource-manager.folder", "resource_id": "b1gfolder", "resource_name": "default"}
+	]},
+	"request_metadata": {"remote_address": "203.0.113.7", "user_agent": "yc/0.120", "request_id": "req1"},
+	"details": {"disk_id": "fhm1", "disk_name": "data"}
+}]`
+
+func TestAuditEventRow(t *testing.T) {
+	var events []*AuditEvent
+	if err := json.Unmarshal([]byte(testAuditEvents), &events); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	row := auditEventRow("trail1", "audit/trail1/2024/03/05/a.json", events[0])
+	if row.SubjectId != "aje1" || row.SubjectType != "SERVICE_ACCOUNT" || row.RemoteAddress != "203.0.113.7" {
+		t.Errorf("unexpected subject or request metadata: %+v", row)
+	}
+	if row.CloudId != "b1gcloud" || row.FolderId != "b1gfolder" || row.FolderName != "default" {
+		t.Errorf("unexpected resource path: %+v", row)
+	}
+	if row.ResourceType != "compute.disk" || row.ResourceId != "fhm1" || row.ResourceName != "data" {
+		t.Errorf("unexpected resource: %s %s %s", row.ResourceType, row.ResourceId, row.ResourceName)
+	}
+	if !row.EventTime.Equal(time.Date(2024, 3, 5, 10, 15, 30, 123000000, time.UTC)) {
+		t.Errorf("unexpected event time: %v", row.EventTime)
+	}
+}
+
+func TestAuditEventResource(t *testing.T) {
+	ev := &AuditEvent{
+		EventType: "yandex.cloud.audit.compute.UpdateInstanceMetadata",
+		Details:   map[string]interface{}{"instance_id": "fhm2"},
+	}
+	if typ, id, _ := auditEventResource(ev); typ != "compute.instance" || id != "fhm2" {
+		t.Errorf("expected compute.instance fhm2, got %s %s", typ, id)
+	}
+	ev = &AuditEvent{
+		EventType: "yandex.cloud.audit.vpc.CreateSecurityGroup",
+		Details:   map[string]interface{}{"security_group_id": "enp1"},
+	}
+	if typ, id, _ := auditEventResource(ev); typ != "vpc.security_group" || id != "enp1" {
+		t.Errorf("expected vpc.security_group enp1, got %s %s", typ, id)
+	}
+	ev = &AuditEvent{EventType: "yandex.cloud.audit.iam.Login"}
+	if typ, id, _ := auditEventResource(ev); typ != "" || id != "" {
+		t.Errorf("expected no resource, got %s %s", typ, id)
+	}
+}
+
+func TestAuditEventDays(t *testing.T) {
+	from := time.Date(2024, 2, 28, 23, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 3, 1, 1, 0, 0, 0, time.UTC)
+	days := auditEventDays(from, to)
+	if len(days) != 3 {
+		t.Fatalf("expected 3 days, got %v", days)
+	}
+	if p := auditEventObjectPrefix("audit/", "trail1", days[1]); p != "audit/trail1/2024/02/29/" {
+		t.Errorf("unexpected prefix: %s", p)
+	}
+	if p := auditEventObjectPrefix("", "trail1", days[2]); p != "trail1/2024/03/01/" {
+		t.Errorf("unexpected prefix: %s", p)
+	}
+}
+
+func TestStorageClient(t *testing.T) {
+	var gz bytes.Buffer
+	zw := gzip.NewWriter(&gz)
+	zw.Write([]byte(testAuditEvents))
+	zw.Close()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		if !strings.Contains(auth, "Credential=AKID/") || !strings.Contains(auth, "/ru-central1/s3/aws4_request") || r.Header.Get("X-Amz-Content-Sha256") == "" {
+			t.Errorf("unexpected signature headers: %s", auth)
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		switch r.URL.Path {
+		case "/audit-bucket":
+			if r.URL.Query().Get("prefix") != "trail1/2024/03/05/" {
+				t.Errorf("unexpected prefix: %s", r.URL.Query().Get("prefix"))
+			}
+			w.Write([]byte(`<ListBucketResult><IsTruncated>false</IsTruncated>
+				<Contents><Key>trail1/2024/03/05/a.json</Key><Size>10</Size></Contents>
+			</ListBucketResult>`))
+		case "/audit-bucket/trail1/2024/03/05/a.json":
+			w.Write(gz.Bytes())
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	key, secret, endpoint := AccessKeyID("AKID"), SecretAccessKey("SECRET"), StorageEndpoint(ts.URL)
+	client, err := NewStorageClient(5, &Config{AccessKeyID: &key, SecretAccessKey: &secret, StorageEndpoint: &endpoint})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ctx := context.WithValue(context.Background(), context_key.Logger, hclog.NewNullLogger())
+	objects, next, err := client.ListStorageObjects(ctx, "audit-bucket", "trail1/2024/03/05/", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(objects) != 1 || next != "" {
+		t.Fatalf("unexpected objects: %v %q", objects, next)
+	}
+	body, err := client.GetStorageObject(ctx, "audit-bucket", objects[0].Key)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(body) != testAuditEvents {
+		t.Errorf("gzip-encoded object was not decompressed: %q", body)
+	}
+}
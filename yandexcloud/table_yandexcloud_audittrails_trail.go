@@ -0,0 +1,156 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+const (
+	AuditTrailDestinationObjectStorage = "OBJECT_STORAGE"
+	AuditTrailDestinationCloudLogging  = "CLOUD_LOGGING"
+	AuditTrailDestinationDataStream    = "DATA_STREAM"
+)
+
+func tableYandexAuditTrailsTrail(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_audittrails_trail",
+		Description: "Yandex Cloud Audit Trails trails.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "trail_id", "name", "status"}),
+			Hydrate:    listYandexAuditTrails,
+		},
+		Columns: []*plugin.Column{
+			{Name: "trail_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "Trail ID."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId"), Description: "Folder ID containing the trail."},
+			{Name: "cloud_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("CloudId").Transform(transform.NullIfZeroValue), Description: "Cloud ID containing the trail."},
+			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name"), Description: "Trail name."},
+			{Name: "description", Type: proto.ColumnType_STRING, Transform: transform.FromField("Description"), Description: "Trail description."},
+			{Name: "created_at", Type: proto.ColumnType_STRING, Transform: transform.From(createdAtAuditTrailDateTransform), Description: "Trail creation date (YYYY-MM-DD)."},
+			{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("UpdatedAt").Transform(transform.NullIfZeroValue), Description: "Time the trail was last updated."},
+			{Name: "labels", Type: proto.ColumnType_JSON, Transform: transform.FromField("Labels"), Description: "Resource labels as key:value pairs."},
+			{Name: "status", Type: proto.ColumnType_STRING, Transform: transform.FromField("Status"), Description: "Trail status (ACTIVE/ERROR/DELETING)."},
+			{Name: "status_error_message", Type: proto.ColumnType_STRING, Transform: transform.FromField("StatusErrorMessage").Transform(transform.NullIfZeroValue), Description: "Reason of the ERROR status."},
+			{Name: "service_account_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ServiceAccountId"), Description: "Service account used to deliver events."},
+			{Name: "destination_type", Type: proto.ColumnType_STRING, Transform: transform.From(auditTrailDestinationTypeTransform), Description: "Destination type (OBJECT_STORAGE/CLOUD_LOGGING/DATA_STREAM)."},
+			{Name: "destination_bucket", Type: proto.ColumnType_STRING, Transform: transform.FromField("Destination.ObjectStorage.BucketId").Transform(transform.NullIfZeroValue), Description: "Object Storage bucket the events are exported to."},
+			{Name: "destination_object_prefix", Type: proto.ColumnType_STRING, Transform: transform.FromField("Destination.ObjectStorage.ObjectPrefix").Transform(transform.NullIfZeroValue), Description: "Prefix of the exported objects in the bucket."},
+			{Name: "destination_log_group_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Destination.CloudLogging.LogGroupId").Transform(transform.NullIfZeroValue), Description: "Cloud Logging log group the events are written to."},
+			{Name: "destination_database_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Destination.DataStream.DatabaseId").Transform(transform.NullIfZeroValue), Description: "YDB database of the Data Streams stream the events are written to."},
+			{Name: "destination_stream_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Destination.DataStream.StreamName").Transform(transform.NullIfZeroValue), Description: "Data Streams stream the events are written to."},
+			{Name: "filter", Type: proto.ColumnType_JSON, Transform: transform.FromField("Filter"), Description: "Legacy filter of the collected events by resource path and event type."},
+			{Name: "filtering_policy", Type: proto.ColumnType_JSON, Transform: transform.FromField("FilteringPolicy"), Description: "Management and data event filtering policy."},
+		},
+	}
+}
+
+func listYandexAuditTrails(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewAuditTrailsClient(tok, 30, cfg)
+
+	var folderIDStr *string
+	if cfg.FolderID != nil {
+		str := string(*cfg.FolderID)
+		folderIDStr = &str
+	}
+	folderID := getQualString(d, "folder_id", folderIDStr)
+	if folderID == "" {
+		return nil, fmt.Errorf("folder_id must be provided")
+	}
+
+	var filters []string
+	if id := getQualString(d, "trail_id", nil); id != "" {
+		filters = append(filters, fmt.Sprintf("(id = \"%s\")", id))
+	}
+	if n := getQualString(d, "name", nil); n != "" {
+		filters = append(filters, fmt.Sprintf("(name = \"%s\")", n))
+	}
+	if st := getQualString(d, "status", nil); st != "" {
+		filters = append(filters, fmt.Sprintf("(status = \"%s\")", st))
+	}
+
+	pageToken := ""
+	pageSize := int64(1000)
+	for {
+		trails, nextPageToken, err := client.ListAuditTrails(ctx, folderID, pageToken, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, trail := range trails {
+			if len(filters) > 0 {
+				if !auditTrailMatchesFilters(trail, filters) {
+					continue
+				}
+			}
+			d.StreamListItem(ctx, trail)
+		}
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return nil, nil
+}
+
+// Manual filtering, since the API does not support filters except folderId
+func auditTrailMatchesFilters(trail *AuditTrail, filters []string) bool {
+	for _, f := range filters {
+		if strings.HasPrefix(f, "(id = ") && !strings.Contains(f, trail.Id) {
+			return false
+		}
+		if strings.HasPrefix(f, "(name = ") && !strings.Contains(f, trail.Name) {
+			return false
+		}
+		if strings.HasPrefix(f, "(status = ") && !strings.Contains(f, trail.Status) {
+			return false
+		}
+	}
+	return true
+}
+
+// auditTrailDestinationType returns the type of the destination set on a trail.
+func auditTrailDestinationType(trail *AuditTrail) string {
+	switch {
+	case trail.Destination.ObjectStorage != nil:
+		return AuditTrailDestinationObjectStorage
+	case trail.Destination.CloudLogging != nil:
+		return AuditTrailDestinationCloudLogging
+	case trail.Destination.DataStream != nil:
+		return AuditTrailDestinationDataStream
+	}
+	return ""
+}
+
+func auditTrailDestinationTypeTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	trail, ok := d.HydrateItem.(*AuditTrail)
+	if !ok {
+		return nil, nil
+	}
+	if t := auditTrailDestinationType(trail); t != "" {
+		return t, nil
+	}
+	return nil, nil
+}
+
+// Transform function for created_at: returns only the date (YYYY-MM-DD)
+func createdAtAuditTrailDateTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if d.HydrateItem == nil {
+		return nil, nil
+	}
+	trail, ok := d.HydrateItem.(*AuditTrail)
+	if !ok || trail.CreatedAt == "" {
+		return nil, nil
+	}
+	if len(trail.CreatedAt) < 10 {
+		return trail.CreatedAt, nil
+	}
+	return trail.CreatedAt[:10], nil
+}
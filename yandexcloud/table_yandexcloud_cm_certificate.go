@@ -0,0 +1,209 @@
+package yandexcloud
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// CMCertificateKeyInfo describes the public key of the leaf certificate of a chain.
+type CMCertificateKeyInfo struct {
+	KeyAlgorithm string
+	KeySize      int
+}
+
+const CMCertificateTypeImported = "IMPORTED"
+
+func tableYandexCMCertificate(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_cm_certificate",
+		Description: "Yandex Cloud Certificate Manager certificates.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "certificate_id", "name", "type", "status"}),
+			Hydrate:    listYandexCMCertificates,
+		},
+		Columns: []*plugin.Column{
+			{Name: "certificate_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "Certificate ID."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId"), Description: "Folder ID containing the certificate."},
+			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name"), Description: "Certificate name."},
+			{Name: "description", Type: proto.ColumnType_STRING, Transform: transform.FromField("Description"), Description: "Certificate description."},
+			{Name: "created_at", Type: proto.ColumnType_STRING, Transform: transform.From(createdAtCMCertificateDateTransform), Description: "Certificate creation date (YYYY-MM-DD)."},
+			{Name: "labels", Type: proto.ColumnType_JSON, Transform: transform.FromField("Labels"), Description: "Resource labels as key:value pairs."},
+			{Name: "type", Type: proto.ColumnType_STRING, Transform: transform.FromField("Type"), Description: "Certificate type (IMPORTED/MANAGED)."},
+			{Name: "domains", Type: proto.ColumnType_JSON, Transform: transform.FromField("Domains"), Description: "Domains of the certificate."},
+			{Name: "status", Type: proto.ColumnType_STRING, Transform: transform.FromField("Status"), Description: "Certificate status, e.g. ISSUED or RENEWAL_FAILED."},
+			{Name: "issuer", Type: proto.ColumnType_STRING, Transform: transform.FromField("Issuer").Transform(transform.NullIfZeroValue), Description: "Distinguished name of the certificate issuer."},
+			{Name: "subject", Type: proto.ColumnType_STRING, Transform: transform.FromField("Subject").Transform(transform.NullIfZeroValue), Description: "Distinguished name of the certificate subject."},
+			{Name: "serial", Type: proto.ColumnType_STRING, Transform: transform.FromField("Serial").Transform(transform.NullIfZeroValue), Description: "Serial number of the certificate."},
+			{Name: "issued_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("IssuedAt").Transform(transform.NullIfZeroValue), Description: "Time the certificate was issued."},
+			{Name: "not_before", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("NotBefore").Transform(transform.NullIfZeroValue), Description: "Time the certificate becomes valid."},
+			{Name: "not_after", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("NotAfter").Transform(transform.NullIfZeroValue), Description: "Time the certificate expires."},
+			{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("UpdatedAt").Transform(transform.NullIfZeroValue), Description: "Time the certificate was last updated."},
+			{Name: "challenges", Type: proto.ColumnType_JSON, Transform: transform.FromField("Challenges"), Description: "Domain validation challenges of a managed certificate with their status."},
+			{Name: "deletion_protection", Type: proto.ColumnType_BOOL, Transform: transform.FromField("DeletionProtection"), Description: "Whether deletion protection is enabled."},
+			{Name: "incomplete_chain", Type: proto.ColumnType_BOOL, Transform: transform.FromField("IncompleteChain"), Description: "Whether the imported certificate chain is incomplete."},
+			{Name: "key_algorithm", Type: proto.ColumnType_STRING, Hydrate: getYandexCMCertificateKeyInfo, Transform: transform.FromField("KeyAlgorithm").Transform(transform.NullIfZeroValue), Description: "Public key algorithm of an imported certificate, parsed from its PEM chain; requires cm_read_certificate_content."},
+			{Name: "key_size", Type: proto.ColumnType_INT, Hydrate: getYandexCMCertificateKeyInfo, Transform: transform.FromField("KeySize").Transform(transform.NullIfZeroValue), Description: "Public key size in bits of an imported certificate, parsed from its PEM chain; requires cm_read_certificate_content."},
+		},
+	}
+}
+
+func listYandexCMCertificates(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewCertificateManagerClient(tok, 30, cfg)
+
+	var folderIDStr *string
+	if cfg.FolderID != nil {
+		str := string(*cfg.FolderID)
+		folderIDStr = &str
+	}
+	folderID := getQualString(d, "folder_id", folderIDStr)
+	if folderID == "" {
+		return nil, fmt.Errorf("folder_id must be provided")
+	}
+
+	var filters []string
+	if id := getQualString(d, "certificate_id", nil); id != "" {
+		filters = append(filters, fmt.Sprintf("(id = \"%s\")", id))
+	}
+	if n := getQualString(d, "name", nil); n != "" {
+		filters = append(filters, fmt.Sprintf("(name = \"%s\")", n))
+	}
+	if t := getQualString(d, "type", nil); t != "" {
+		filters = append(filters, fmt.Sprintf("(type = \"%s\")", t))
+	}
+	if st := getQualString(d, "status", nil); st != "" {
+		filters = append(filters, fmt.Sprintf("(status = \"%s\")", st))
+	}
+
+	pageToken := ""
+	pageSize := int64(1000)
+	for {
+		certs, nextPageToken, err := client.ListCMCertificates(ctx, folderID, pageToken, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, cert := range certs {
+			if len(filters) > 0 {
+				if !cmCertificateMatchesFilters(cert, filters) {
+					continue
+				}
+			}
+			d.StreamListItem(ctx, cert)
+		}
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return nil, nil
+}
+
+// getYandexCMCertificateKeyInfo fetches the chain of an imported certificate and parses its public key.
+// The content API returns the private key along with the chain and requires the
+// certificate-manager.certificates.downloader role, so it is only called when
+// cm_read_certificate_content is enabled. The private key is never decoded.
+func getYandexCMCertificateKeyInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	cert, ok := h.Item.(*CMCertificate)
+	if !ok || cert.Type != CMCertificateTypeImported {
+		return &CMCertificateKeyInfo{}, nil
+	}
+	cfg := getConfig(d)
+	if cfg.CMReadCertificateContent == nil || !*cfg.CMReadCertificateContent {
+		return &CMCertificateKeyInfo{}, nil
+	}
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewCertificateManagerClient(tok, 30, cfg)
+	chain, err := client.GetCMCertificateChain(ctx, CMCertificateID(cert.Id))
+	if err != nil {
+		return nil, err
+	}
+	info, err := cmCertificateKeyInfo(chain)
+	if err != nil {
+		LogError(ctx, "Failed to parse certificate chain of %s: %v", cert.Id, err)
+		return &CMCertificateKeyInfo{}, nil
+	}
+	return info, nil
+}
+
+// cmCertificateKeyInfo parses the first (leaf) certificate of a PEM chain.
+func cmCertificateKeyInfo(chain []string) (*CMCertificateKeyInfo, error) {
+	for _, item := range chain {
+		rest := []byte(item)
+		for {
+			var block *pem.Block
+			block, rest = pem.Decode(rest)
+			if block == nil {
+				break
+			}
+			if block.Type != "CERTIFICATE" {
+				continue
+			}
+			leaf, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, err
+			}
+			info := &CMCertificateKeyInfo{KeyAlgorithm: leaf.PublicKeyAlgorithm.String()}
+			switch key := leaf.PublicKey.(type) {
+			case *rsa.PublicKey:
+				info.KeySize = key.N.BitLen()
+			case *ecdsa.PublicKey:
+				info.KeySize = key.Curve.Params().BitSize
+			case ed25519.PublicKey:
+				info.KeySize = len(key) * 8
+			}
+			return info, nil
+		}
+	}
+	return nil, fmt.Errorf("no certificate found in chain")
+}
+
+// Manual filtering, since the API does not support filters except folderId
+func cmCertificateMatchesFilters(cert *CMCertificate, filters []string) bool {
+	for _, f := range filters {
+		if strings.HasPrefix(f, "(id = ") && !strings.Contains(f, cert.Id) {
+			return false
+		}
+		if strings.HasPrefix(f, "(name = ") && !strings.Contains(f, cert.Name) {
+			return false
+		}
+		if strings.HasPrefix(f, "(type = ") && !strings.Contains(f, cert.Type) {
+			return false
+		}
+		if strings.HasPrefix(f, "(status = ") && !strings.Contains(f, cert.Status) {
+			return false
+		}
+	}
+	return true
+}
+
+// Transform function for created_at: returns only the date (YYYY-MM-DD)
+func createdAtCMCertificateDateTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if d.HydrateItem == nil {
+		return nil, nil
+	}
+	cert, ok := d.HydrateItem.(*CMCertificate)
+	if !ok || cert.CreatedAt == "" {
+		return nil, nil
+	}
+	if len(cert.CreatedAt) < 10 {
+		return cert.CreatedAt, nil
+	}
+	return cert.CreatedAt[:10], nil
+}
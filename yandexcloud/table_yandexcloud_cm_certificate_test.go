@@ -0,0 +1,57 @@
+package yandexcloud
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+)
+
+func TestCMCertificateKeyInfo(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	leaf := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+
+	info, err := cmCertificateKeyInfo([]string{leaf})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if info.KeyAlgorithm != "ECDSA" || info.KeySize != 256 {
+		t.Errorf("unexpected key info: %+v", info)
+	}
+
+	if _, err := cmCertificateKeyInfo([]string{"not a certificate"}); err == nil {
+		t.Errorf("expected error for invalid chain")
+	}
+}
+
+func TestCMCertificateKeyInfoRequiresOptIn(t *testing.T) {
+	h := &plugin.HydrateData{Item: &CMCertificate{Id: "cert1", Type: CMCertificateTypeImported}}
+	info, err := getYandexCMCertificateKeyInfo(context.Background(), nil, h)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ki := info.(*CMCertificateKeyInfo); ki.KeyAlgorithm != "" || ki.KeySize != 0 {
+		t.Errorf("certificate content must not be read without cm_read_certificate_content: %+v", ki)
+	}
+}
@@ -35,6 +35,7 @@ func tableYandexComputeDisk(_ context.Context) *plugin.Table {
 			{Name: "source_image_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("SourceImageId"), Description: "Source image ID."},
 			{Name: "source_snapshot_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("SourceSnapshotId"), Description: "Source snapshot ID."},
 			{Name: "block_size", Type: proto.ColumnType_STRING, Transform: transform.FromField("BlockSize"), Description: "Block size (bytes)."},
+			{Name: "instance_ids", Type: proto.ColumnType_JSON, Transform: transform.FromField("InstanceIds"), Description: "IDs of the instances the disk is attached to."},
 			{Name: "labels", Type: proto.ColumnType_JSON, Transform: transform.FromField("Labels"), Description: "Resource labels as key:value pairs."},
 		},
 	}
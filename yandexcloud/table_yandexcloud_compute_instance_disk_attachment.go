@@ -0,0 +1,142 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// InstanceDiskAttachment links a compute instance to one of its attached disks.
+type InstanceDiskAttachment struct {
+	InstanceId   string
+	InstanceName string
+	FolderId     string
+	ZoneId       string
+	DiskId       string
+	DeviceName   string
+	Mode         string
+	AutoDelete   bool
+	IsBoot       bool
+}
+
+func tableYandexComputeInstanceDiskAttachment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_compute_instance_disk_attachment",
+		Description: "Yandex Cloud Compute disks attached to instances, one row per attachment.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "instance_id", "disk_id", "zone"}),
+			Hydrate:    listYandexComputeInstanceDiskAttachments,
+		},
+		Columns: []*plugin.Column{
+			{Name: "instance_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("InstanceId"), Description: "Instance ID."},
+			{Name: "instance_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("InstanceName"), Description: "Instance name."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId"), Description: "Folder ID containing the instance."},
+			{Name: "zone", Type: proto.ColumnType_STRING, Transform: transform.FromField("ZoneId"), Description: "Availability zone."},
+			{Name: "disk_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("DiskId"), Description: "Attached disk ID."},
+			{Name: "device_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("DeviceName"), Description: "Device name of the disk inside the instance."},
+			{Name: "mode", Type: proto.ColumnType_STRING, Transform: transform.FromField("Mode"), Description: "Access mode (READ_ONLY/READ_WRITE)."},
+			{Name: "auto_delete", Type: proto.ColumnType_BOOL, Transform: transform.FromField("AutoDelete"), Description: "Whether the disk is deleted together with the instance."},
+			{Name: "is_boot", Type: proto.ColumnType_BOOL, Transform: transform.FromField("IsBoot"), Description: "Whether the disk is the boot disk."},
+		},
+	}
+}
+
+func listYandexComputeInstanceDiskAttachments(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		LogError(ctx, "Failed to get token: %v", err)
+		return nil, err
+	}
+	client := NewComputeClient(tok, 30, cfg)
+
+	timeoutSec := TimeoutSec(30)
+	if cfg.Timeout != nil && *cfg.Timeout > 0 {
+		timeoutSec = TimeoutSec(*cfg.Timeout)
+	}
+	retryCount := RetryCount(3)
+	if cfg.Retry != nil && *cfg.Retry > 0 {
+		retryCount = RetryCount(*cfg.Retry)
+	}
+	diskID := getQualString(d, "disk_id", nil)
+
+	if id := getQualString(d, "instance_id", nil); id != "" {
+		inst, err := client.GetInstance(ctx, InstanceID(id), timeoutSec, retryCount)
+		if err != nil {
+			return nil, err
+		}
+		streamInstanceDiskAttachments(ctx, d, inst, diskID)
+		return nil, nil
+	}
+
+	var folderIDStr *string
+	if cfg.FolderID != nil {
+		str := string(*cfg.FolderID)
+		folderIDStr = &str
+	}
+	folderID := FolderID(getQualString(d, "folder_id", folderIDStr))
+	if folderID == "" {
+		return nil, fmt.Errorf("folder_id must be provided")
+	}
+
+	var filter Filter
+	if z := getQualString(d, "zone", nil); z != "" {
+		filter = Filter(fmt.Sprintf("(zoneId = \"%s\")", z))
+	}
+	pageToken := PageToken("")
+	pageSize := PageSize(1000)
+	for {
+		instances, nextPageToken, err := client.ListInstances(ctx, folderID, filter, pageToken, pageSize, timeoutSec, retryCount)
+		if err != nil {
+			return nil, err
+		}
+		for _, inst := range instances {
+			streamInstanceDiskAttachments(ctx, d, inst, diskID)
+		}
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return nil, nil
+}
+
+func streamInstanceDiskAttachments(ctx context.Context, d *plugin.QueryData, inst *Instance, diskID string) {
+	for _, att := range instanceDiskAttachments(inst) {
+		if diskID != "" && att.DiskId != diskID {
+			continue
+		}
+		d.StreamListItem(ctx, att)
+	}
+}
+
+// instanceDiskAttachments returns the boot disk followed by the secondary disks of the instance.
+func instanceDiskAttachments(inst *Instance) []*InstanceDiskAttachment {
+	if inst == nil {
+		return nil
+	}
+	var rows []*InstanceDiskAttachment
+	add := func(disk AttachedDisk, isBoot bool) {
+		rows = append(rows, &InstanceDiskAttachment{
+			InstanceId:   inst.Id,
+			InstanceName: inst.Name,
+			FolderId:     inst.FolderId,
+			ZoneId:       string(inst.ZoneId),
+			DiskId:       disk.DiskId,
+			DeviceName:   disk.DeviceName,
+			Mode:         disk.Mode,
+			AutoDelete:   disk.AutoDelete,
+			IsBoot:       isBoot,
+		})
+	}
+	if inst.BootDisk != nil {
+		add(*inst.BootDisk, true)
+	}
+	for _, disk := range inst.SecondaryDisks {
+		add(disk, false)
+	}
+	return rows
+}
@@ -0,0 +1,52 @@
+package yandexcloud
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestInstanceDiskAttachments(t *testing.T) {
+	raw := `{
+		"id": "inst1",
+		"name": "db",
+		"folderId": "folder1",
+		"zoneId": "ru-central1-b",
+		"bootDisk": {"mode": "READ_WRITE", "deviceName": "boot", "autoDelete": true, "diskId": "disk1"},
+		"secondaryDisks": [
+			{"mode": "READ_WRITE", "deviceName": "data", "diskId": "disk2"},
+			{"mode": "READ_ONLY", "deviceName": "iso", "diskId": "disk3"}
+		]
+	}`
+	var inst Instance
+	if err := json.Unmarshal([]byte(raw), &inst); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rows := instanceDiskAttachments(&inst)
+	if len(rows) != 3 {
+		t.Fatalf("expected 3 rows, got %d", len(rows))
+	}
+	boot := rows[0]
+	if !boot.IsBoot || boot.DiskId != "disk1" || !boot.AutoDelete || boot.DeviceName != "boot" {
+		t.Errorf("unexpected boot disk: %+v", boot)
+	}
+	if boot.InstanceId != "inst1" || boot.InstanceName != "db" || boot.FolderId != "folder1" || boot.ZoneId != "ru-central1-b" {
+		t.Errorf("unexpected instance fields: %+v", boot)
+	}
+	for _, att := range rows[1:] {
+		if att.IsBoot || att.AutoDelete {
+			t.Errorf("unexpected secondary disk: %+v", att)
+		}
+	}
+	if rows[2].DiskId != "disk3" || rows[2].Mode != "READ_ONLY" {
+		t.Errorf("unexpected secondary disk order: %+v", rows[2])
+	}
+
+	inst.BootDisk = nil
+	inst.SecondaryDisks = nil
+	if rows := instanceDiskAttachments(&inst); len(rows) != 0 {
+		t.Errorf("expected no rows, got %d", len(rows))
+	}
+	if rows := instanceDiskAttachments(nil); rows != nil {
+		t.Errorf("expected nil rows for nil instance")
+	}
+}
@@ -0,0 +1,153 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+func tableYandexComputeInstanceGroup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_compute_instance_group",
+		Description: "Yandex Cloud Compute instance groups.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "name"}),
+			Hydrate:    listYandexComputeInstanceGroups,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("instance_group_id"),
+			Hydrate:    getYandexComputeInstanceGroup,
+		},
+		Columns: []*plugin.Column{
+			{Name: "instance_group_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "Instance group ID."},
+			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name"), Description: "Instance group name."},
+			{Name: "description", Type: proto.ColumnType_STRING, Transform: transform.FromField("Description"), Description: "Instance group description."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId"), Description: "Folder ID containing the instance group."},
+			{Name: "status", Type: proto.ColumnType_STRING, Transform: transform.FromField("Status"), Description: "Current status."},
+			{Name: "created_at", Type: proto.ColumnType_STRING, Transform: transform.From(createdAtInstanceGroupDateTransform), Description: "Instance group creation date (YYYY-MM-DD)."},
+			{Name: "labels", Type: proto.ColumnType_JSON, Transform: transform.FromField("Labels"), Description: "Resource labels as key:value pairs."},
+			{Name: "service_account_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ServiceAccountId"), Description: "Service account ID used to manage the group."},
+			{Name: "deletion_protection", Type: proto.ColumnType_BOOL, Transform: transform.FromField("DeletionProtection"), Description: "Deletion protection flag."},
+			{Name: "instance_template", Type: proto.ColumnType_JSON, Transform: transform.FromField("InstanceTemplate"), Description: "Template used to create instances in the group."},
+			{Name: "scale_policy", Type: proto.ColumnType_JSON, Transform: transform.FromField("ScalePolicy"), Description: "Scaling policy (fixed or auto scale)."},
+			{Name: "deploy_policy", Type: proto.ColumnType_JSON, Transform: transform.FromField("DeployPolicy"), Description: "Deployment policy."},
+			{Name: "allocation_zones", Type: proto.ColumnType_JSON, Transform: transform.From(instanceGroupAllocationZonesTransform), Description: "Availability zones where instances are allocated."},
+			{Name: "target_group_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("LoadBalancerState.TargetGroupId"), Description: "Network load balancer target group ID."},
+			{Name: "load_balancer_status_message", Type: proto.ColumnType_STRING, Transform: transform.FromField("LoadBalancerState.StatusMessage"), Description: "Status message of the target group."},
+			{Name: "load_balancer_spec", Type: proto.ColumnType_JSON, Transform: transform.FromField("LoadBalancerSpec"), Description: "Load balancer target group specification."},
+			{Name: "health_checks_spec", Type: proto.ColumnType_JSON, Transform: transform.FromField("HealthChecksSpec"), Description: "Health check specification."},
+			{Name: "target_size", Type: proto.ColumnType_INT, Transform: transform.FromField("ManagedInstancesState.TargetSize"), Description: "Target number of instances."},
+			{Name: "running_actual_count", Type: proto.ColumnType_INT, Transform: transform.FromField("ManagedInstancesState.RunningActualCount"), Description: "Number of running instances matching the current template."},
+			{Name: "running_outdated_count", Type: proto.ColumnType_INT, Transform: transform.FromField("ManagedInstancesState.RunningOutdatedCount"), Description: "Number of running instances that do not match the current template."},
+			{Name: "processing_count", Type: proto.ColumnType_INT, Transform: transform.FromField("ManagedInstancesState.ProcessingCount"), Description: "Number of instances being processed."},
+		},
+	}
+}
+
+func listYandexComputeInstanceGroups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		LogError(ctx, "Failed to get token: %v", err)
+		return nil, err
+	}
+	client := NewComputeClient(tok, 30, cfg)
+
+	var folderIDStr *string
+	if cfg.FolderID != nil {
+		str := string(*cfg.FolderID)
+		folderIDStr = &str
+	}
+	folderID := FolderID(getQualString(d, "folder_id", folderIDStr))
+	if folderID == "" {
+		return nil, fmt.Errorf("folder_id must be provided")
+	}
+
+	var filter Filter
+	if n := getQualString(d, "name", nil); n != "" {
+		filter = Filter(fmt.Sprintf("name = \"%s\"", n))
+	}
+	pageToken := PageToken("")
+	pageSize := PageSize(1000)
+	timeoutSec := TimeoutSec(30)
+	if cfg.Timeout != nil && *cfg.Timeout > 0 {
+		timeoutSec = TimeoutSec(*cfg.Timeout)
+	}
+	retryCount := RetryCount(3)
+	if cfg.Retry != nil && *cfg.Retry > 0 {
+		retryCount = RetryCount(*cfg.Retry)
+	}
+	for {
+		groups, nextPageToken, err := client.ListInstanceGroups(ctx, folderID, filter, pageToken, pageSize, timeoutSec, retryCount)
+		if err != nil {
+			return nil, err
+		}
+		for _, group := range groups {
+			d.StreamListItem(ctx, group)
+		}
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return nil, nil
+}
+
+func getYandexComputeInstanceGroup(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var groupID string
+	if h != nil && h.Item != nil {
+		if group, ok := h.Item.(*InstanceGroup); ok {
+			groupID = group.Id
+		}
+	}
+	if groupID == "" {
+		if v, ok := d.KeyColumnQuals["instance_group_id"]; ok {
+			groupID = v.GetStringValue()
+		}
+	}
+	if groupID == "" {
+		return nil, nil
+	}
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewComputeClient(tok, 30, cfg)
+	group, err := client.GetInstanceGroup(ctx, InstanceGroupID(groupID), 30, 3)
+	if err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+// Transform function for allocation_zones: returns the list of zone IDs
+func instanceGroupAllocationZonesTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	group, ok := d.HydrateItem.(*InstanceGroup)
+	if !ok || group.AllocationPolicy == nil {
+		return nil, nil
+	}
+	zones := make([]string, 0, len(group.AllocationPolicy.Zones))
+	for _, z := range group.AllocationPolicy.Zones {
+		zones = append(zones, z.ZoneId)
+	}
+	return zones, nil
+}
+
+// Transform function for created_at: returns only the date (YYYY-MM-DD)
+func createdAtInstanceGroupDateTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if d.HydrateItem == nil {
+		return nil, nil
+	}
+	group, ok := d.HydrateItem.(*InstanceGroup)
+	if !ok || group.CreatedAt == "" {
+		return nil, nil
+	}
+	if len(group.CreatedAt) < 10 {
+		return group.CreatedAt, nil
+	}
+	return group.CreatedAt[:10], nil
+}
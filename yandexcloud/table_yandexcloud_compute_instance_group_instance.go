@@ -0,0 +1,110 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+func tableYandexComputeInstanceGroupInstance(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_compute_instance_group_instance",
+		Description: "Yandex Cloud Compute instances managed by instance groups.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "instance_group_id", "status"}),
+			Hydrate:    listYandexComputeInstanceGroupInstances,
+		},
+		Columns: []*plugin.Column{
+			{Name: "managed_instance_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "Managed instance ID."},
+			{Name: "instance_group_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("InstanceGroupId"), Description: "Instance group ID."},
+			{Name: "instance_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("InstanceId"), Description: "Compute instance ID."},
+			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name"), Description: "Instance name."},
+			{Name: "fqdn", Type: proto.ColumnType_STRING, Transform: transform.FromField("Fqdn"), Description: "Fully qualified domain name."},
+			{Name: "status", Type: proto.ColumnType_STRING, Transform: transform.FromField("Status"), Description: "Managed instance status."},
+			{Name: "status_message", Type: proto.ColumnType_STRING, Transform: transform.FromField("StatusMessage"), Description: "Status message."},
+			{Name: "status_changed_at", Type: proto.ColumnType_STRING, Transform: transform.FromField("StatusChangedAt"), Description: "Time of the last status change (RFC3339)."},
+			{Name: "zone", Type: proto.ColumnType_STRING, Transform: transform.FromField("ZoneId"), Description: "Availability zone."},
+			{Name: "instance_tag", Type: proto.ColumnType_STRING, Transform: transform.FromField("InstanceTag"), Description: "Instance tag."},
+			{Name: "network_interfaces", Type: proto.ColumnType_JSON, Transform: transform.FromField("NetworkInterfaces"), Description: "Network interfaces attached to the instance."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId"), Description: "Folder ID containing the instance group."},
+		},
+	}
+}
+
+func listYandexComputeInstanceGroupInstances(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		LogError(ctx, "Failed to get token: %v", err)
+		return nil, err
+	}
+	client := NewComputeClient(tok, 30, cfg)
+
+	var filter Filter
+	if st := getQualString(d, "status", nil); st != "" {
+		filter = Filter(fmt.Sprintf("status = \"%s\"", strings.ToUpper(st)))
+	}
+	pageSize := PageSize(1000)
+	timeoutSec := TimeoutSec(30)
+	if cfg.Timeout != nil && *cfg.Timeout > 0 {
+		timeoutSec = TimeoutSec(*cfg.Timeout)
+	}
+	retryCount := RetryCount(3)
+	if cfg.Retry != nil && *cfg.Retry > 0 {
+		retryCount = RetryCount(*cfg.Retry)
+	}
+
+	var groups []*InstanceGroup
+	if id := getQualString(d, "instance_group_id", nil); id != "" {
+		group, err := client.GetInstanceGroup(ctx, InstanceGroupID(id), timeoutSec, retryCount)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, group)
+	} else {
+		var folderIDStr *string
+		if cfg.FolderID != nil {
+			str := string(*cfg.FolderID)
+			folderIDStr = &str
+		}
+		folderID := FolderID(getQualString(d, "folder_id", folderIDStr))
+		if folderID == "" {
+			return nil, fmt.Errorf("folder_id or instance_group_id must be provided")
+		}
+		pageToken := PageToken("")
+		for {
+			page, nextPageToken, err := client.ListInstanceGroups(ctx, folderID, "", pageToken, pageSize, timeoutSec, retryCount)
+			if err != nil {
+				return nil, err
+			}
+			groups = append(groups, page...)
+			if nextPageToken == "" {
+				break
+			}
+			pageToken = nextPageToken
+		}
+	}
+
+	for _, group := range groups {
+		pageToken := PageToken("")
+		for {
+			instances, nextPageToken, err := client.ListInstanceGroupInstances(ctx, InstanceGroupID(group.Id), filter, pageToken, pageSize, timeoutSec, retryCount)
+			if err != nil {
+				return nil, err
+			}
+			for _, inst := range instances {
+				inst.FolderId = group.FolderId
+				d.StreamListItem(ctx, inst)
+			}
+			if nextPageToken == "" {
+				break
+			}
+			pageToken = nextPageToken
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,164 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// InstanceNetworkInterface is a single network interface of a compute instance, flattened for SQL.
+type InstanceNetworkInterface struct {
+	InstanceId       string
+	InstanceName     string
+	FolderId         string
+	ZoneId           string
+	Index            string
+	SubnetId         string
+	MacAddress       string
+	PrimaryV4Address string
+	PrimaryV6Address string
+	NatAddress       string
+	NatIpVersion     string
+	DnsRecords       []DnsRecord
+	NatDnsRecords    []DnsRecord
+	SecurityGroupIds []string
+}
+
+func tableYandexComputeInstanceNetworkInterface(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_compute_instance_network_interface",
+		Description: "Yandex Cloud Compute instance network interfaces, one row per interface.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "instance_id", "subnet_id", "zone"}),
+			Hydrate:    listYandexComputeInstanceNetworkInterfaces,
+		},
+		Columns: []*plugin.Column{
+			{Name: "instance_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("InstanceId"), Description: "Instance ID."},
+			{Name: "instance_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("InstanceName"), Description: "Instance name."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId"), Description: "Folder ID containing the instance."},
+			{Name: "zone", Type: proto.ColumnType_STRING, Transform: transform.FromField("ZoneId"), Description: "Availability zone."},
+			{Name: "index", Type: proto.ColumnType_INT, Transform: transform.FromField("Index"), Description: "Index of the network interface."},
+			{Name: "subnet_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("SubnetId"), Description: "Subnet ID the interface is connected to."},
+			{Name: "mac_address", Type: proto.ColumnType_STRING, Transform: transform.FromField("MacAddress"), Description: "MAC address of the interface."},
+			{Name: "primary_v4_address", Type: proto.ColumnType_INET, Transform: transform.FromField("PrimaryV4Address").Transform(transform.NullIfZeroValue), Description: "Primary internal IPv4 address."},
+			{Name: "primary_v6_address", Type: proto.ColumnType_INET, Transform: transform.FromField("PrimaryV6Address").Transform(transform.NullIfZeroValue), Description: "Primary internal IPv6 address."},
+			{Name: "nat_address", Type: proto.ColumnType_INET, Transform: transform.FromField("NatAddress").Transform(transform.NullIfZeroValue), Description: "Public address of the one-to-one NAT."},
+			{Name: "nat_ip_version", Type: proto.ColumnType_STRING, Transform: transform.FromField("NatIpVersion").Transform(transform.NullIfZeroValue), Description: "IP version of the one-to-one NAT address (IPV4/IPV6)."},
+			{Name: "dns_records", Type: proto.ColumnType_JSON, Transform: transform.FromField("DnsRecords"), Description: "Internal DNS records of the primary addresses."},
+			{Name: "nat_dns_records", Type: proto.ColumnType_JSON, Transform: transform.FromField("NatDnsRecords"), Description: "External DNS records of the one-to-one NAT address."},
+			{Name: "security_group_ids", Type: proto.ColumnType_JSON, Transform: transform.FromField("SecurityGroupIds"), Description: "Security group IDs attached to the interface."},
+		},
+	}
+}
+
+func listYandexComputeInstanceNetworkInterfaces(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		LogError(ctx, "Failed to get token: %v", err)
+		return nil, err
+	}
+	client := NewComputeClient(tok, 30, cfg)
+
+	timeoutSec := TimeoutSec(30)
+	if cfg.Timeout != nil && *cfg.Timeout > 0 {
+		timeoutSec = TimeoutSec(*cfg.Timeout)
+	}
+	retryCount := RetryCount(3)
+	if cfg.Retry != nil && *cfg.Retry > 0 {
+		retryCount = RetryCount(*cfg.Retry)
+	}
+	subnetID := getQualString(d, "subnet_id", nil)
+
+	if id := getQualString(d, "instance_id", nil); id != "" {
+		inst, err := client.GetInstance(ctx, InstanceID(id), timeoutSec, retryCount)
+		if err != nil {
+			return nil, err
+		}
+		streamInstanceNetworkInterfaces(ctx, d, inst, subnetID)
+		return nil, nil
+	}
+
+	var folderIDStr *string
+	if cfg.FolderID != nil {
+		str := string(*cfg.FolderID)
+		folderIDStr = &str
+	}
+	folderID := FolderID(getQualString(d, "folder_id", folderIDStr))
+	if folderID == "" {
+		return nil, fmt.Errorf("folder_id must be provided")
+	}
+
+	var filter Filter
+	if z := getQualString(d, "zone", nil); z != "" {
+		filter = Filter(fmt.Sprintf("(zoneId = \"%s\")", z))
+	}
+	pageToken := PageToken("")
+	pageSize := PageSize(1000)
+	for {
+		instances, nextPageToken, err := client.ListInstances(ctx, folderID, filter, pageToken, pageSize, timeoutSec, retryCount)
+		if err != nil {
+			return nil, err
+		}
+		for _, inst := range instances {
+			streamInstanceNetworkInterfaces(ctx, d, inst, subnetID)
+		}
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return nil, nil
+}
+
+func streamInstanceNetworkInterfaces(ctx context.Context, d *plugin.QueryData, inst *Instance, subnetID string) {
+	for _, nic := range flattenInstanceNetworkInterfaces(inst) {
+		if subnetID != "" && !strings.EqualFold(nic.SubnetId, subnetID) {
+			continue
+		}
+		d.StreamListItem(ctx, nic)
+	}
+}
+
+// flattenInstanceNetworkInterfaces returns one row per network interface of the instance.
+func flattenInstanceNetworkInterfaces(inst *Instance) []*InstanceNetworkInterface {
+	if inst == nil {
+		return nil
+	}
+	rows := make([]*InstanceNetworkInterface, 0, len(inst.NetworkInterfaces))
+	for _, ni := range inst.NetworkInterfaces {
+		row := &InstanceNetworkInterface{
+			InstanceId:       inst.Id,
+			InstanceName:     inst.Name,
+			FolderId:         inst.FolderId,
+			ZoneId:           string(inst.ZoneId),
+			Index:            ni.Index,
+			SubnetId:         ni.SubnetId,
+			MacAddress:       ni.MacAddress,
+			SecurityGroupIds: ni.SecurityGroupIds,
+		}
+		for _, addr := range []*PrimaryAddress{ni.PrimaryV4Address, ni.PrimaryV6Address} {
+			if addr == nil {
+				continue
+			}
+			row.DnsRecords = append(row.DnsRecords, addr.DnsRecords...)
+			if addr.OneToOneNat != nil && row.NatAddress == "" {
+				row.NatAddress = addr.OneToOneNat.Address
+				row.NatIpVersion = addr.OneToOneNat.IpVersion
+				row.NatDnsRecords = addr.OneToOneNat.DnsRecords
+			}
+		}
+		if ni.PrimaryV4Address != nil {
+			row.PrimaryV4Address = ni.PrimaryV4Address.Address
+		}
+		if ni.PrimaryV6Address != nil {
+			row.PrimaryV6Address = ni.PrimaryV6Address.Address
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
@@ -0,0 +1,46 @@
+package yandexcloud
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFlattenInstanceNetworkInterfaces(t *testing.T) {
+	raw := `{
+		"id": "inst1",
+		"name": "web",
+		"folderId": "folder1",
+		"zoneId": "ru-central1-a",
+		"networkInterfaces": [{
+			"index": "0",
+			"macAddress": "d0:0d:11:22:33:44",
+			"subnetId": "subnet1",
+			"primaryV4Address": {
+				"address": "10.0.0.5",
+				"oneToOneNat": {"address": "51.250.1.2", "ipVersion": "IPV4"}
+			},
+			"securityGroupIds": ["sg1", "sg2"]
+		}]
+	}`
+	var inst Instance
+	if err := json.Unmarshal([]byte(raw), &inst); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rows := flattenInstanceNetworkInterfaces(&inst)
+	if len(rows) != 1 {
+		t.Fatalf("expected 1 row, got %d", len(rows))
+	}
+	nic := rows[0]
+	if nic.InstanceId != "inst1" || nic.SubnetId != "subnet1" || nic.Index != "0" {
+		t.Errorf("unexpected identifiers: %+v", nic)
+	}
+	if nic.PrimaryV4Address != "10.0.0.5" || nic.PrimaryV6Address != "" {
+		t.Errorf("unexpected primary addresses: %+v", nic)
+	}
+	if nic.NatAddress != "51.250.1.2" || nic.NatIpVersion != "IPV4" {
+		t.Errorf("unexpected NAT address: %+v", nic)
+	}
+	if len(nic.SecurityGroupIds) != 2 {
+		t.Errorf("expected 2 security groups, got %v", nic.SecurityGroupIds)
+	}
+}
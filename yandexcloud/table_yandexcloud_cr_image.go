@@ -0,0 +1,134 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// CRImageRow is an image with the registry and folder it belongs to.
+type CRImageRow struct {
+	Id             string
+	RegistryId     string
+	FolderId       string
+	RepositoryName string
+	Digest         string
+	Tags           []string
+	CompressedSize int64
+	CreatedAt      string
+}
+
+func tableYandexCRImage(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_cr_image",
+		Description: "Yandex Cloud Container Registry images.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "registry_id", "image_id", "repository_name", "digest"}),
+			Hydrate:    listYandexCRImages,
+		},
+		Columns: []*plugin.Column{
+			{Name: "image_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "Image ID."},
+			{Name: "registry_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("RegistryId"), Description: "ID of the registry containing the image."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId"), Description: "Folder ID containing the registry."},
+			{Name: "repository_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("RepositoryName"), Description: "Name of the repository containing the image."},
+			{Name: "digest", Type: proto.ColumnType_STRING, Transform: transform.FromField("Digest"), Description: "Content-addressable digest of the image."},
+			{Name: "tags", Type: proto.ColumnType_JSON, Transform: transform.FromField("Tags"), Description: "Tags of the image."},
+			{Name: "compressed_size", Type: proto.ColumnType_INT, Transform: transform.FromField("CompressedSize"), Description: "Compressed size of the image, in bytes."},
+			{Name: "created_at", Type: proto.ColumnType_STRING, Transform: transform.From(createdAtCRImageDateTransform), Description: "Image creation date (YYYY-MM-DD)."},
+		},
+	}
+}
+
+func listYandexCRImages(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewContainerRegistryClient(tok, 30, cfg)
+
+	var folderIDStr *string
+	if cfg.FolderID != nil {
+		str := string(*cfg.FolderID)
+		folderIDStr = &str
+	}
+	folderID := getQualString(d, "folder_id", folderIDStr)
+	if folderID == "" {
+		return nil, fmt.Errorf("folder_id must be provided")
+	}
+	images, err := listCRImageRows(ctx, d, client, folderID)
+	if err != nil {
+		return nil, err
+	}
+	for _, img := range images {
+		d.StreamListItem(ctx, img)
+	}
+	return nil, nil
+}
+
+// listCRImageRows lists images of all registries of a folder, applying the registry_id, image_id,
+// repository_name and digest quals of the calling table.
+func listCRImageRows(ctx context.Context, d *plugin.QueryData, client ContainerRegistryClient, folderID string) ([]*CRImageRow, error) {
+	imageID := getQualString(d, "image_id", nil)
+	repositoryName := getQualString(d, "repository_name", nil)
+	digest := getQualString(d, "digest", nil)
+
+	registries, err := listAllCRRegistries(ctx, client, folderID, getQualString(d, "registry_id", nil))
+	if err != nil {
+		return nil, err
+	}
+	var rows []*CRImageRow
+	for _, registry := range registries {
+		pageToken := ""
+		for {
+			images, nextPageToken, err := client.ListCRImages(ctx, CRRegistryID(registry.Id), pageToken, 1000)
+			if err != nil {
+				return nil, err
+			}
+			for _, img := range images {
+				if imageID != "" && img.Id != imageID {
+					continue
+				}
+				if repositoryName != "" && img.Name != repositoryName {
+					continue
+				}
+				if digest != "" && img.Digest != digest {
+					continue
+				}
+				rows = append(rows, &CRImageRow{
+					Id:             img.Id,
+					RegistryId:     registry.Id,
+					FolderId:       registry.FolderId,
+					RepositoryName: img.Name,
+					Digest:         img.Digest,
+					Tags:           img.Tags,
+					CompressedSize: img.CompressedSize,
+					CreatedAt:      img.CreatedAt,
+				})
+			}
+			if nextPageToken == "" {
+				break
+			}
+			pageToken = nextPageToken
+		}
+	}
+	return rows, nil
+}
+
+// Transform function for created_at: returns only the date (YYYY-MM-DD)
+func createdAtCRImageDateTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if d.HydrateItem == nil {
+		return nil, nil
+	}
+	img, ok := d.HydrateItem.(*CRImageRow)
+	if !ok || img.CreatedAt == "" {
+		return nil, nil
+	}
+	if len(img.CreatedAt) < 10 {
+		return img.CreatedAt, nil
+	}
+	return img.CreatedAt[:10], nil
+}
@@ -0,0 +1,138 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// CRLifecyclePolicyRow is a lifecycle policy with the registry it belongs to.
+type CRLifecyclePolicyRow struct {
+	Id             string
+	Name           string
+	RegistryId     string
+	FolderId       string
+	RepositoryId   string
+	RepositoryName string
+	Description    string
+	Status         string
+	CreatedAt      string
+	Rules          []map[string]interface{}
+}
+
+func tableYandexCRLifecyclePolicy(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_cr_lifecycle_policy",
+		Description: "Yandex Cloud Container Registry lifecycle policies.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "registry_id", "repository_id", "status"}),
+			Hydrate:    listYandexCRLifecyclePolicies,
+		},
+		Columns: []*plugin.Column{
+			{Name: "lifecycle_policy_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "Lifecycle policy ID."},
+			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name"), Description: "Lifecycle policy name."},
+			{Name: "registry_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("RegistryId"), Description: "ID of the registry containing the repository."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId"), Description: "Folder ID containing the registry."},
+			{Name: "repository_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("RepositoryId"), Description: "ID of the repository the policy applies to."},
+			{Name: "repository_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("RepositoryName").Transform(transform.NullIfZeroValue), Description: "Name of the repository the policy applies to."},
+			{Name: "description", Type: proto.ColumnType_STRING, Transform: transform.FromField("Description"), Description: "Lifecycle policy description."},
+			{Name: "status", Type: proto.ColumnType_STRING, Transform: transform.FromField("Status"), Description: "Lifecycle policy status (ACTIVE/DISABLED)."},
+			{Name: "created_at", Type: proto.ColumnType_STRING, Transform: transform.From(createdAtCRLifecyclePolicyDateTransform), Description: "Lifecycle policy creation date (YYYY-MM-DD)."},
+			{Name: "rules", Type: proto.ColumnType_JSON, Transform: transform.FromField("Rules"), Description: "Cleanup rules: tag regexp, untagged flag, expire period and number of retained images."},
+		},
+	}
+}
+
+func listYandexCRLifecyclePolicies(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewContainerRegistryClient(tok, 30, cfg)
+
+	var folderIDStr *string
+	if cfg.FolderID != nil {
+		str := string(*cfg.FolderID)
+		folderIDStr = &str
+	}
+	folderID := getQualString(d, "folder_id", folderIDStr)
+	if folderID == "" {
+		return nil, fmt.Errorf("folder_id must be provided")
+	}
+	repositoryID := getQualString(d, "repository_id", nil)
+	status := getQualString(d, "status", nil)
+
+	registries, err := listAllCRRegistries(ctx, client, folderID, getQualString(d, "registry_id", nil))
+	if err != nil {
+		return nil, err
+	}
+	repositoryNames := map[string]string{}
+	pageToken := ""
+	for {
+		repositories, nextPageToken, err := client.ListCRRepositories(ctx, folderID, pageToken, 1000)
+		if err != nil {
+			return nil, err
+		}
+		for _, repo := range repositories {
+			repositoryNames[repo.Id] = repo.Name
+		}
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+
+	for _, registry := range registries {
+		pageToken := ""
+		for {
+			policies, nextPageToken, err := client.ListCRLifecyclePolicies(ctx, CRRegistryID(registry.Id), pageToken, 1000)
+			if err != nil {
+				return nil, err
+			}
+			for _, p := range policies {
+				if repositoryID != "" && p.RepositoryId != repositoryID {
+					continue
+				}
+				if status != "" && p.Status != status {
+					continue
+				}
+				d.StreamListItem(ctx, &CRLifecyclePolicyRow{
+					Id:             p.Id,
+					Name:           p.Name,
+					RegistryId:     registry.Id,
+					FolderId:       registry.FolderId,
+					RepositoryId:   p.RepositoryId,
+					RepositoryName: repositoryNames[p.RepositoryId],
+					Description:    p.Description,
+					Status:         p.Status,
+					CreatedAt:      p.CreatedAt,
+					Rules:          p.Rules,
+				})
+			}
+			if nextPageToken == "" {
+				break
+			}
+			pageToken = nextPageToken
+		}
+	}
+	return nil, nil
+}
+
+// Transform function for created_at: returns only the date (YYYY-MM-DD)
+func createdAtCRLifecyclePolicyDateTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if d.HydrateItem == nil {
+		return nil, nil
+	}
+	p, ok := d.HydrateItem.(*CRLifecyclePolicyRow)
+	if !ok || p.CreatedAt == "" {
+		return nil, nil
+	}
+	if len(p.CreatedAt) < 10 {
+		return p.CreatedAt, nil
+	}
+	return p.CreatedAt[:10], nil
+}
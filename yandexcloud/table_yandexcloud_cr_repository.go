@@ -0,0 +1,102 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// CRRepositoryRow is a repository with the registry and folder it belongs to.
+type CRRepositoryRow struct {
+	Id         string
+	Name       string
+	RegistryId string
+	FolderId   string
+}
+
+func tableYandexCRRepository(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_cr_repository",
+		Description: "Yandex Cloud Container Registry repositories.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "registry_id", "repository_id", "name"}),
+			Hydrate:    listYandexCRRepositories,
+		},
+		Columns: []*plugin.Column{
+			{Name: "repository_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "Repository ID."},
+			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name"), Description: "Repository name, prefixed with the registry ID."},
+			{Name: "registry_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("RegistryId"), Description: "ID of the registry containing the repository."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId"), Description: "Folder ID containing the registry."},
+		},
+	}
+}
+
+func listYandexCRRepositories(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewContainerRegistryClient(tok, 30, cfg)
+
+	var folderIDStr *string
+	if cfg.FolderID != nil {
+		str := string(*cfg.FolderID)
+		folderIDStr = &str
+	}
+	folderID := getQualString(d, "folder_id", folderIDStr)
+	if folderID == "" {
+		return nil, fmt.Errorf("folder_id must be provided")
+	}
+	registryID := getQualString(d, "registry_id", nil)
+	repositoryID := getQualString(d, "repository_id", nil)
+	name := getQualString(d, "name", nil)
+
+	pageToken := ""
+	pageSize := int64(1000)
+	for {
+		repositories, nextPageToken, err := client.ListCRRepositories(ctx, folderID, pageToken, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, repo := range repositories {
+			row := crRepositoryRow(repo, folderID)
+			if registryID != "" && row.RegistryId != registryID {
+				continue
+			}
+			if repositoryID != "" && row.Id != repositoryID {
+				continue
+			}
+			if name != "" && row.Name != name {
+				continue
+			}
+			d.StreamListItem(ctx, row)
+		}
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return nil, nil
+}
+
+func crRepositoryRow(repo *CRRepository, folderID string) *CRRepositoryRow {
+	return &CRRepositoryRow{
+		Id:         repo.Id,
+		Name:       repo.Name,
+		RegistryId: crRegistryIDFromName(repo.Name),
+		FolderId:   folderID,
+	}
+}
+
+// crRegistryIDFromName returns the registry ID prefix of a repository or image name.
+func crRegistryIDFromName(name string) string {
+	if i := strings.Index(name, "/"); i > 0 {
+		return name[:i]
+	}
+	return ""
+}
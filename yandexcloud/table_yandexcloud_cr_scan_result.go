@@ -0,0 +1,111 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// CRScanResultRow is the latest vulnerability scan of an image.
+type CRScanResultRow struct {
+	Id             string
+	ImageId        string
+	RegistryId     string
+	FolderId       string
+	RepositoryName string
+	Digest         string
+	Tags           []string
+	ScannedAt      string
+	Status         string
+	Critical       int64
+	High           int64
+	Medium         int64
+	Low            int64
+	Negligible     int64
+	Undefined      int64
+}
+
+func tableYandexCRScanResult(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_cr_scan_result",
+		Description: "Latest vulnerability scan results of Yandex Cloud Container Registry images.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "registry_id", "image_id", "repository_name", "digest"}),
+			Hydrate:    listYandexCRScanResults,
+		},
+		Columns: []*plugin.Column{
+			{Name: "scan_result_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "Scan result ID."},
+			{Name: "image_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ImageId"), Description: "ID of the scanned image."},
+			{Name: "registry_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("RegistryId"), Description: "ID of the registry containing the image."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId"), Description: "Folder ID containing the registry."},
+			{Name: "repository_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("RepositoryName"), Description: "Name of the repository containing the image."},
+			{Name: "digest", Type: proto.ColumnType_STRING, Transform: transform.FromField("Digest"), Description: "Digest of the scanned image."},
+			{Name: "tags", Type: proto.ColumnType_JSON, Transform: transform.FromField("Tags"), Description: "Tags of the scanned image."},
+			{Name: "scanned_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("ScannedAt").Transform(transform.NullIfZeroValue), Description: "Time the scan finished."},
+			{Name: "status", Type: proto.ColumnType_STRING, Transform: transform.FromField("Status"), Description: "Scan status (RUNNING/READY/ERROR)."},
+			{Name: "critical", Type: proto.ColumnType_INT, Transform: transform.FromField("Critical"), Description: "Number of critical vulnerabilities."},
+			{Name: "high", Type: proto.ColumnType_INT, Transform: transform.FromField("High"), Description: "Number of high severity vulnerabilities."},
+			{Name: "medium", Type: proto.ColumnType_INT, Transform: transform.FromField("Medium"), Description: "Number of medium severity vulnerabilities."},
+			{Name: "low", Type: proto.ColumnType_INT, Transform: transform.FromField("Low"), Description: "Number of low severity vulnerabilities."},
+			{Name: "negligible", Type: proto.ColumnType_INT, Transform: transform.FromField("Negligible"), Description: "Number of negligible vulnerabilities."},
+			{Name: "undefined", Type: proto.ColumnType_INT, Transform: transform.FromField("Undefined"), Description: "Number of vulnerabilities with undefined severity."},
+		},
+	}
+}
+
+func listYandexCRScanResults(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewContainerRegistryClient(tok, 30, cfg)
+
+	var folderIDStr *string
+	if cfg.FolderID != nil {
+		str := string(*cfg.FolderID)
+		folderIDStr = &str
+	}
+	folderID := getQualString(d, "folder_id", folderIDStr)
+	if folderID == "" {
+		return nil, fmt.Errorf("folder_id must be provided")
+	}
+	images, err := listCRImageRows(ctx, d, client, folderID)
+	if err != nil {
+		return nil, err
+	}
+	for _, img := range images {
+		scan, err := client.GetLastCRScanResult(ctx, CRImageID(img.Id))
+		if err != nil {
+			return nil, err
+		}
+		if scan == nil {
+			continue
+		}
+		d.StreamListItem(ctx, crScanResultRow(img, scan))
+	}
+	return nil, nil
+}
+
+func crScanResultRow(img *CRImageRow, scan *CRScanResult) *CRScanResultRow {
+	return &CRScanResultRow{
+		Id:             scan.Id,
+		ImageId:        img.Id,
+		RegistryId:     img.RegistryId,
+		FolderId:       img.FolderId,
+		RepositoryName: img.RepositoryName,
+		Digest:         img.Digest,
+		Tags:           img.Tags,
+		ScannedAt:      scan.ScannedAt,
+		Status:         scan.Status,
+		Critical:       scan.Vulnerabilities.Critical,
+		High:           scan.Vulnerabilities.High,
+		Medium:         scan.Vulnerabilities.Medium,
+		Low:            scan.Vulnerabilities.Low,
+		Negligible:     scan.Vulnerabilities.Negligible,
+		Undefined:      scan.Vulnerabilities.Undefined,
+	}
+}
@@ -0,0 +1,135 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// CRVulnerabilityRow is a vulnerability found by the latest scan of an image.
+type CRVulnerabilityRow struct {
+	ScanResultId   string
+	ImageId        string
+	RegistryId     string
+	FolderId       string
+	RepositoryName string
+	Digest         string
+	Tags           []string
+	Severity       string
+	Name           string
+	Link           string
+	PackageName    string
+	PackageVersion string
+	FixedBy        string
+	Source         string
+	Origin         string
+	Type           string
+}
+
+func tableYandexCRVulnerability(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_cr_vulnerability",
+		Description: "Vulnerabilities found by the latest scan of Yandex Cloud Container Registry images.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "registry_id", "image_id", "repository_name", "digest", "severity"}),
+			Hydrate:    listYandexCRVulnerabilities,
+		},
+		Columns: []*plugin.Column{
+			{Name: "scan_result_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ScanResultId"), Description: "ID of the scan result."},
+			{Name: "image_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ImageId"), Description: "ID of the scanned image."},
+			{Name: "registry_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("RegistryId"), Description: "ID of the registry containing the image."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId"), Description: "Folder ID containing the registry."},
+			{Name: "repository_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("RepositoryName"), Description: "Name of the repository containing the image."},
+			{Name: "digest", Type: proto.ColumnType_STRING, Transform: transform.FromField("Digest"), Description: "Digest of the scanned image."},
+			{Name: "tags", Type: proto.ColumnType_JSON, Transform: transform.FromField("Tags"), Description: "Tags of the scanned image."},
+			{Name: "severity", Type: proto.ColumnType_STRING, Transform: transform.FromField("Severity"), Description: "Severity (CRITICAL/HIGH/MEDIUM/LOW/NEGLIGIBLE/UNDEFINED)."},
+			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name"), Description: "Vulnerability identifier, e.g. CVE-2024-1234."},
+			{Name: "link", Type: proto.ColumnType_STRING, Transform: transform.FromField("Link").Transform(transform.NullIfZeroValue), Description: "Link to the vulnerability description."},
+			{Name: "package_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("PackageName"), Description: "Name of the vulnerable package."},
+			{Name: "package_version", Type: proto.ColumnType_STRING, Transform: transform.FromField("PackageVersion"), Description: "Installed version of the vulnerable package."},
+			{Name: "fixed_by", Type: proto.ColumnType_STRING, Transform: transform.FromField("FixedBy").Transform(transform.NullIfZeroValue), Description: "Package version that fixes the vulnerability."},
+			{Name: "source", Type: proto.ColumnType_STRING, Transform: transform.FromField("Source").Transform(transform.NullIfZeroValue), Description: "Source of the package, e.g. the OS distribution."},
+			{Name: "origin", Type: proto.ColumnType_STRING, Transform: transform.FromField("Origin").Transform(transform.NullIfZeroValue), Description: "Origin of the package."},
+			{Name: "type", Type: proto.ColumnType_STRING, Transform: transform.FromField("Type").Transform(transform.NullIfZeroValue), Description: "Type of the package."},
+		},
+	}
+}
+
+func listYandexCRVulnerabilities(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewContainerRegistryClient(tok, 30, cfg)
+
+	var folderIDStr *string
+	if cfg.FolderID != nil {
+		str := string(*cfg.FolderID)
+		folderIDStr = &str
+	}
+	folderID := getQualString(d, "folder_id", folderIDStr)
+	if folderID == "" {
+		return nil, fmt.Errorf("folder_id must be provided")
+	}
+	severity := getQualString(d, "severity", nil)
+
+	images, err := listCRImageRows(ctx, d, client, folderID)
+	if err != nil {
+		return nil, err
+	}
+	for _, img := range images {
+		scan, err := client.GetLastCRScanResult(ctx, CRImageID(img.Id))
+		if err != nil {
+			return nil, err
+		}
+		if scan == nil {
+			continue
+		}
+		pageToken := ""
+		for {
+			vulnerabilities, nextPageToken, err := client.ListCRVulnerabilities(ctx, CRScanResultID(scan.Id), pageToken, 1000)
+			if err != nil {
+				return nil, err
+			}
+			for _, v := range vulnerabilities {
+				if severity != "" && v.Severity != severity {
+					continue
+				}
+				d.StreamListItem(ctx, crVulnerabilityRow(img, scan, v))
+			}
+			if nextPageToken == "" {
+				break
+			}
+			pageToken = nextPageToken
+		}
+	}
+	return nil, nil
+}
+
+func crVulnerabilityRow(img *CRImageRow, scan *CRScanResult, v *CRVulnerability) *CRVulnerabilityRow {
+	row := &CRVulnerabilityRow{
+		ScanResultId:   scan.Id,
+		ImageId:        img.Id,
+		RegistryId:     img.RegistryId,
+		FolderId:       img.FolderId,
+		RepositoryName: img.RepositoryName,
+		Digest:         img.Digest,
+		Tags:           img.Tags,
+		Severity:       v.Severity,
+	}
+	if p := v.Package; p != nil {
+		row.Name = p.Name
+		row.Link = p.Link
+		row.PackageName = p.Package
+		row.PackageVersion = p.Version
+		row.FixedBy = p.FixedBy
+		row.Source = p.Source
+		row.Origin = p.Origin
+		row.Type = p.Type
+	}
+	return row
+}
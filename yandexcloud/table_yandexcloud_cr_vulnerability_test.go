@@ -0,0 +1,43 @@
+package yandexcloud
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCRVulnerabilityRow(t *testing.T) {
+	raw := `{"severity": "CRITICAL", "package": {"name": "CVE-2024-0001", "link": "https://example.com/CVE-2024-0001", "package": "openssl", "source": "debian:12", "version": "3.0.1", "fixedBy": "3.0.2"}}`
+	var v CRVulnerability
+	if err := json.Unmarshal([]byte(raw), &v); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	img := &CRImageRow{Id: "img1", RegistryId: "crp1", RepositoryName: "crp1/app", Digest: "sha256:abc", Tags: []string{"prod"}}
+	row := crVulnerabilityRow(img, &CRScanResult{Id: "scan1"}, &v)
+	if row.Name != "CVE-2024-0001" || row.PackageName != "openssl" || row.PackageVersion != "3.0.1" || row.FixedBy != "3.0.2" {
+		t.Errorf("unexpected package fields: %+v", row)
+	}
+	if row.ScanResultId != "scan1" || row.ImageId != "img1" || row.Severity != "CRITICAL" || row.Digest != "sha256:abc" {
+		t.Errorf("unexpected image fields: %+v", row)
+	}
+}
+
+func TestCRScanResultStats(t *testing.T) {
+	raw := `{"id": "scan1", "imageId": "img1", "status": "READY", "vulnerabilities": {"critical": "2", "high": "5", "low": "1"}}`
+	var scan CRScanResult
+	if err := json.Unmarshal([]byte(raw), &scan); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	row := crScanResultRow(&CRImageRow{Id: "img1"}, &scan)
+	if row.Critical != 2 || row.High != 5 || row.Medium != 0 || row.Low != 1 {
+		t.Errorf("unexpected severity counts: %+v", row)
+	}
+}
+
+func TestCRRegistryIDFromName(t *testing.T) {
+	if id := crRegistryIDFromName("crp123/team/app"); id != "crp123" {
+		t.Errorf("expected crp123, got %q", id)
+	}
+	if id := crRegistryIDFromName("app"); id != "" {
+		t.Errorf("expected empty registry ID, got %q", id)
+	}
+}
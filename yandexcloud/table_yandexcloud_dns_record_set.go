@@ -0,0 +1,125 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// DNSRecordSetRow is a record set together with the zone it belongs to.
+type DNSRecordSetRow struct {
+	DnsZoneId  string
+	Zone       string
+	Visibility string
+	FolderId   string
+	Name       string
+	Type       string
+	Ttl        int64
+	Data       []string
+}
+
+func tableYandexDNSRecordSet(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_dns_record_set",
+		Description: "Yandex Cloud DNS record sets, one row per name and type.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "dns_zone_id", "name", "type"}),
+			Hydrate:    listYandexDNSRecordSets,
+		},
+		Columns: []*plugin.Column{
+			{Name: "dns_zone_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("DnsZoneId"), Description: "DNS zone ID."},
+			{Name: "zone", Type: proto.ColumnType_STRING, Transform: transform.FromField("Zone"), Description: "DNS zone suffix, e.g. example.com."},
+			{Name: "visibility", Type: proto.ColumnType_STRING, Transform: transform.FromField("Visibility"), Description: "Zone visibility (PUBLIC/PRIVATE)."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId"), Description: "Folder ID containing the DNS zone."},
+			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name"), Description: "Domain name of the record set."},
+			{Name: "type", Type: proto.ColumnType_STRING, Transform: transform.FromField("Type"), Description: "Record type, e.g. A, CNAME or TXT."},
+			{Name: "ttl", Type: proto.ColumnType_INT, Transform: transform.FromField("Ttl"), Description: "Time to live in seconds."},
+			{Name: "data", Type: proto.ColumnType_JSON, Transform: transform.FromField("Data"), Description: "Data of the records."},
+		},
+	}
+}
+
+func listYandexDNSRecordSets(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewDNSClient(tok, 30, cfg)
+
+	filter := dnsRecordSetFilter(getQualString(d, "name", nil), getQualString(d, "type", nil))
+
+	var zones []*DNSZone
+	if zoneID := getQualString(d, "dns_zone_id", nil); zoneID != "" {
+		zone, err := client.GetDNSZone(ctx, DNSZoneID(zoneID))
+		if err != nil {
+			return nil, err
+		}
+		zones = append(zones, zone)
+	} else {
+		var folderIDStr *string
+		if cfg.FolderID != nil {
+			str := string(*cfg.FolderID)
+			folderIDStr = &str
+		}
+		folderID := getQualString(d, "folder_id", folderIDStr)
+		if folderID == "" {
+			return nil, fmt.Errorf("folder_id or dns_zone_id must be provided")
+		}
+		pageToken := ""
+		for {
+			page, nextPageToken, err := client.ListDNSZones(ctx, folderID, "", pageToken, 1000)
+			if err != nil {
+				return nil, err
+			}
+			zones = append(zones, page...)
+			if nextPageToken == "" {
+				break
+			}
+			pageToken = nextPageToken
+		}
+	}
+
+	for _, zone := range zones {
+		pageToken := ""
+		for {
+			recordSets, nextPageToken, err := client.ListDNSRecordSets(ctx, DNSZoneID(zone.Id), filter, pageToken, 1000)
+			if err != nil {
+				return nil, err
+			}
+			for _, rs := range recordSets {
+				d.StreamListItem(ctx, &DNSRecordSetRow{
+					DnsZoneId:  zone.Id,
+					Zone:       zone.Zone,
+					Visibility: dnsZoneVisibility(zone),
+					FolderId:   zone.FolderId,
+					Name:       rs.Name,
+					Type:       rs.Type,
+					Ttl:        rs.Ttl,
+					Data:       rs.Data,
+				})
+			}
+			if nextPageToken == "" {
+				break
+			}
+			pageToken = nextPageToken
+		}
+	}
+	return nil, nil
+}
+
+// dnsRecordSetFilter builds the listRecordSets filter expression from the name and type quals.
+func dnsRecordSetFilter(name, recordType string) string {
+	var conds []string
+	if name != "" {
+		conds = append(conds, fmt.Sprintf("name = %q", name))
+	}
+	if recordType != "" {
+		conds = append(conds, fmt.Sprintf("type = %q", recordType))
+	}
+	return strings.Join(conds, " AND ")
+}
@@ -0,0 +1,19 @@
+package yandexcloud
+
+import "testing"
+
+func TestDNSRecordSetFilter(t *testing.T) {
+	cases := []struct {
+		name, recordType, want string
+	}{
+		{"", "", ""},
+		{"www", "", `name = "www"`},
+		{"", "A", `type = "A"`},
+		{"www", "CNAME", `name = "www" AND type = "CNAME"`},
+	}
+	for _, c := range cases {
+		if got := dnsRecordSetFilter(c.name, c.recordType); got != c.want {
+			t.Errorf("dnsRecordSetFilter(%q, %q) = %q, want %q", c.name, c.recordType, got, c.want)
+		}
+	}
+}
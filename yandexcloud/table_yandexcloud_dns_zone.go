@@ -0,0 +1,137 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+const (
+	DNSZoneVisibilityPublic  = "PUBLIC"
+	DNSZoneVisibilityPrivate = "PRIVATE"
+)
+
+func tableYandexDNSZone(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_dns_zone",
+		Description: "Yandex Cloud DNS zones.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "dns_zone_id", "name", "zone"}),
+			Hydrate:    listYandexDNSZones,
+		},
+		Columns: []*plugin.Column{
+			{Name: "dns_zone_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "DNS zone ID."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId"), Description: "Folder ID containing the DNS zone."},
+			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name"), Description: "DNS zone name."},
+			{Name: "description", Type: proto.ColumnType_STRING, Transform: transform.FromField("Description"), Description: "DNS zone description."},
+			{Name: "created_at", Type: proto.ColumnType_STRING, Transform: transform.From(createdAtDNSZoneDateTransform), Description: "DNS zone creation date (YYYY-MM-DD)."},
+			{Name: "labels", Type: proto.ColumnType_JSON, Transform: transform.FromField("Labels"), Description: "Resource labels as key:value pairs."},
+			{Name: "zone", Type: proto.ColumnType_STRING, Transform: transform.FromField("Zone"), Description: "DNS zone suffix, e.g. example.com."},
+			{Name: "visibility", Type: proto.ColumnType_STRING, Transform: transform.From(dnsZoneVisibilityTransform), Description: "Zone visibility (PUBLIC/PRIVATE)."},
+			{Name: "network_ids", Type: proto.ColumnType_JSON, Transform: transform.FromField("PrivateVisibility.NetworkIds"), Description: "IDs of the networks the zone is visible from."},
+			{Name: "deletion_protection", Type: proto.ColumnType_BOOL, Transform: transform.FromField("DeletionProtection"), Description: "Whether deletion protection is enabled."},
+		},
+	}
+}
+
+func listYandexDNSZones(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewDNSClient(tok, 30, cfg)
+
+	var folderIDStr *string
+	if cfg.FolderID != nil {
+		str := string(*cfg.FolderID)
+		folderIDStr = &str
+	}
+	folderID := getQualString(d, "folder_id", folderIDStr)
+	if folderID == "" {
+		return nil, fmt.Errorf("folder_id must be provided")
+	}
+
+	// The API filters zones by name; the other quals are matched below.
+	var nameFilter string
+	var filters []string
+	if id := getQualString(d, "dns_zone_id", nil); id != "" {
+		filters = append(filters, fmt.Sprintf("(id = \"%s\")", id))
+	}
+	if n := getQualString(d, "name", nil); n != "" {
+		nameFilter = fmt.Sprintf("name = %q", n)
+	}
+	if z := getQualString(d, "zone", nil); z != "" {
+		filters = append(filters, fmt.Sprintf("(zone = \"%s\")", z))
+	}
+
+	pageToken := ""
+	pageSize := int64(1000)
+	for {
+		zones, nextPageToken, err := client.ListDNSZones(ctx, folderID, nameFilter, pageToken, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, zone := range zones {
+			if len(filters) > 0 {
+				if !dnsZoneMatchesFilters(zone, filters) {
+					continue
+				}
+			}
+			d.StreamListItem(ctx, zone)
+		}
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return nil, nil
+}
+
+// Manual filtering for the quals the API cannot filter on
+func dnsZoneMatchesFilters(zone *DNSZone, filters []string) bool {
+	for _, f := range filters {
+		if strings.HasPrefix(f, "(id = ") && !strings.Contains(f, zone.Id) {
+			return false
+		}
+		if strings.HasPrefix(f, "(zone = ") && !strings.Contains(f, zone.Zone) {
+			return false
+		}
+	}
+	return true
+}
+
+func dnsZoneVisibility(zone *DNSZone) string {
+	if zone.PublicVisibility != nil {
+		return DNSZoneVisibilityPublic
+	}
+	return DNSZoneVisibilityPrivate
+}
+
+// Transform function for visibility: a zone with public visibility is public even if it is also attached to networks
+func dnsZoneVisibilityTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	zone, ok := d.HydrateItem.(*DNSZone)
+	if !ok {
+		return nil, nil
+	}
+	return dnsZoneVisibility(zone), nil
+}
+
+// Transform function for created_at: returns only the date (YYYY-MM-DD)
+func createdAtDNSZoneDateTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if d.HydrateItem == nil {
+		return nil, nil
+	}
+	zone, ok := d.HydrateItem.(*DNSZone)
+	if !ok || zone.CreatedAt == "" {
+		return nil, nil
+	}
+	if len(zone.CreatedAt) < 10 {
+		return zone.CreatedAt, nil
+	}
+	return zone.CreatedAt[:10], nil
+}
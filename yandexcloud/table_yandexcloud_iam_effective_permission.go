@@ -0,0 +1,426 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// Levels of the resource hierarchy access bindings can be granted at.
+const (
+	IAMLevelOrganization = "organization"
+	IAMLevelCloud        = "cloud"
+	IAMLevelFolder       = "folder"
+	IAMLevelResource     = "resource"
+)
+
+// Resource types of the scopes resolved by yandexcloud_iam_effective_permission.
+const (
+	IAMResourceOrganization = "organization-manager.organization"
+	IAMResourceCloud        = "resource-manager.cloud"
+	IAMResourceFolder       = "resource-manager.folder"
+	IAMResourceLockboxSec   = "lockbox.secret"
+	IAMResourceFunction     = "serverless.function"
+	IAMResourceCRRegistry   = "container-registry.registry"
+)
+
+// Subject types of access bindings.
+const (
+	IAMSubjectTypeUserAccount   = "userAccount"
+	IAMSubjectTypeFederatedUser = "federatedUser"
+	IAMSubjectTypeGroup         = "group"
+	IAMSubjectTypeSystem        = "system"
+)
+
+// System subjects standing for every account, which cannot be expanded to their members.
+const (
+	IAMSubjectAllUsers              = "allUsers"
+	IAMSubjectAllAuthenticatedUsers = "allAuthenticatedUsers"
+)
+
+// IAMBindingScope is a resource together with the access bindings granted directly on it.
+// ParentId is the ID of the enclosing scope and is empty for an organization.
+type IAMBindingScope struct {
+	Level        string
+	ResourceType string
+	ResourceId   string
+	ParentId     string
+	CloudId      string
+	FolderId     string
+	Bindings     []*AccessBinding
+}
+
+// IAMEffectivePermissionRow is a role a subject holds on a resource, either granted directly
+// or inherited from an enclosing scope, a group or a role that includes it.
+type IAMEffectivePermissionRow struct {
+	SubjectId      string
+	SubjectType    string
+	ViaGroupId     string
+	ResourceType   string
+	ResourceId     string
+	CloudId        string
+	FolderId       string
+	RoleId         string
+	GrantedRoleId  string
+	GrantedAtLevel string
+	GrantedOnId    string
+	Inherited      bool
+	Implied        bool
+	PublicAccess   bool
+}
+
+func tableYandexIAMEffectivePermission(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_iam_effective_permission",
+		Description: "Roles Yandex Cloud subjects effectively hold on organizations, clouds, folders and resources.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"cloud_id", "folder_id", "subject_id", "role_id", "resource_type"}),
+			Hydrate:    listYandexIAMEffectivePermissions,
+		},
+		Columns: []*plugin.Column{
+			{Name: "subject_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("SubjectId"), Description: "ID of the subject holding the role."},
+			{Name: "subject_type", Type: proto.ColumnType_STRING, Transform: transform.FromField("SubjectType"), Description: "Type of the subject, e.g. userAccount, federatedUser, serviceAccount, group or system."},
+			{Name: "via_group_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ViaGroupId").Transform(transform.NullIfZeroValue), Description: "ID of the group the role was granted to, when the subject holds it as a group member."},
+			{Name: "resource_type", Type: proto.ColumnType_STRING, Transform: transform.FromField("ResourceType"), Description: "Type of the resource, e.g. resource-manager.folder or lockbox.secret."},
+			{Name: "resource_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ResourceId"), Description: "ID of the resource the role applies to."},
+			{Name: "cloud_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("CloudId").Transform(transform.NullIfZeroValue), Description: "Cloud ID containing the resource."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId").Transform(transform.NullIfZeroValue), Description: "Folder ID containing the resource."},
+			{Name: "role_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("RoleId"), Description: "Effective role, e.g. viewer or lockbox.payloadViewer."},
+			{Name: "granted_role_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("GrantedRoleId"), Description: "Role of the access binding the effective role comes from."},
+			{Name: "granted_at_level", Type: proto.ColumnType_STRING, Transform: transform.FromField("GrantedAtLevel"), Description: "Level of the access binding: organization, cloud, folder or resource."},
+			{Name: "granted_on_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("GrantedOnId"), Description: "ID of the organization, cloud, folder or resource the access binding is granted on."},
+			{Name: "inherited", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Inherited"), Description: "Whether the role is inherited from an enclosing organization, cloud or folder."},
+			{Name: "implied", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Implied"), Description: "Whether the role is included in the granted role, e.g. viewer in editor."},
+			{Name: "public_access", Type: proto.ColumnType_BOOL, Transform: transform.FromField("PublicAccess"), Description: "Whether the role is granted to allUsers or allAuthenticatedUsers, i.e. to anyone or to any Yandex Cloud account."},
+		},
+	}
+}
+
+func listYandexIAMEffectivePermissions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	rmClient := NewResourceManagerClient(tok, 30, cfg)
+	orgClient := NewOrganizationClient(tok, 30, cfg)
+
+	roles, err := listAllIAMRoles(ctx, NewIAMClient(tok, 30, cfg))
+	if err != nil {
+		return nil, err
+	}
+	catalog := iamRoleCatalog(roles)
+
+	var cloudIDStr *string
+	if cfg.CloudID != nil {
+		str := string(*cfg.CloudID)
+		cloudIDStr = &str
+	}
+	cloudID := getQualString(d, "cloud_id", cloudIDStr)
+	folderID := getQualString(d, "folder_id", nil)
+	resourceType := getQualString(d, "resource_type", nil)
+	wantResources := func(rt string) bool {
+		return resourceType == "" || resourceType == rt
+	}
+	subjectID := getQualString(d, "subject_id", nil)
+	roleID := getQualString(d, "role_id", nil)
+	implied := func(id string) []string {
+		return iamImpliedRoleIDs(id, catalog)
+	}
+
+	clouds, err := listAllResourceManagerClouds(ctx, rmClient)
+	if err != nil {
+		return nil, err
+	}
+	// Each cloud is resolved and streamed on its own, together with its organization, whose
+	// own rows are only streamed with its first cloud.
+	orgs := map[string]*iamOrganizationScope{}
+	for _, cloud := range clouds {
+		if cloudID != "" && cloud.Id != cloudID {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		var scopes []*IAMBindingScope
+		var groupMembers map[string][]*OrganizationGroupMemberRow
+		var org *iamOrganizationScope
+		if orgID := cloud.OrganizationId; orgID != "" {
+			if org = orgs[orgID]; org == nil {
+				if org, err = listIAMOrganizationScope(ctx, orgClient, orgID); err != nil {
+					return nil, err
+				}
+				orgs[orgID] = org
+			}
+			scopes = append(scopes, org.Scope)
+			groupMembers = org.GroupMembers
+		}
+		bindings, err := collectPages(func(pageToken string) ([]*AccessBinding, string, error) {
+			return rmClient.ListCloudAccessBindings(ctx, cloud.Id, pageToken, 1000)
+		})
+		if err != nil {
+			return nil, err
+		}
+		scopes = append(scopes, &IAMBindingScope{Level: IAMLevelCloud, ResourceType: IAMResourceCloud, ResourceId: cloud.Id, ParentId: cloud.OrganizationId, CloudId: cloud.Id, Bindings: bindings})
+		folderScopes, err := listIAMFolderScopes(ctx, cfg, tok, rmClient, cloud.Id, folderID, wantResources)
+		if err != nil {
+			return nil, err
+		}
+		scopes = append(scopes, folderScopes...)
+
+		for _, row := range resolveEffectivePermissions(scopes, groupMembers, implied, subjectID, roleID) {
+			if !wantResources(row.ResourceType) {
+				continue
+			}
+			if row.ResourceType == IAMResourceOrganization && org.Streamed {
+				continue
+			}
+			// Scopes above the requested folder are only needed for inheritance.
+			if folderID != "" && (row.ResourceType == IAMResourceOrganization || row.ResourceType == IAMResourceCloud) {
+				continue
+			}
+			d.StreamListItem(ctx, row)
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if org != nil {
+			org.Streamed = true
+		}
+	}
+	return nil, nil
+}
+
+// iamOrganizationScope is an organization with its access bindings and the members of its groups.
+type iamOrganizationScope struct {
+	Scope        *IAMBindingScope
+	GroupMembers map[string][]*OrganizationGroupMemberRow
+	// Streamed is set once the rows of the organization itself have been streamed.
+	Streamed bool
+}
+
+// listIAMOrganizationScope reads the access bindings and group members of an organization. The
+// members of the system groups of all organization users and of the users of each federation are
+// listed under the subject IDs those groups have in access bindings.
+func listIAMOrganizationScope(ctx context.Context, client OrganizationClient, orgID string) (*iamOrganizationScope, error) {
+	bindings, err := collectPages(func(pageToken string) ([]*AccessBinding, string, error) {
+		return client.ListOrganizationAccessBindings(ctx, orgID, pageToken, 1000)
+	})
+	if err != nil {
+		return nil, err
+	}
+	org := &iamOrganizationScope{
+		Scope:        &IAMBindingScope{Level: IAMLevelOrganization, ResourceType: IAMResourceOrganization, ResourceId: orgID, Bindings: bindings},
+		GroupMembers: map[string][]*OrganizationGroupMemberRow{},
+	}
+	members, err := listAllOrganizationGroupMembers(ctx, client, orgID, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range members {
+		org.GroupMembers[m.GroupId] = append(org.GroupMembers[m.GroupId], m)
+	}
+	users, err := collectPages(func(pageToken string) ([]*OrganizationUser, string, error) {
+		return client.ListOrganizationUsers(ctx, orgID, pageToken, 1000)
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range users {
+		groupIDs := []string{iamOrganizationUsersSubjectID(orgID)}
+		subjectType := IAMSubjectTypeUserAccount
+		if fed := u.SubjectClaims.Federation; fed != nil {
+			groupIDs = append(groupIDs, iamFederationUsersSubjectID(fed.Id))
+			subjectType = IAMSubjectTypeFederatedUser
+		}
+		for _, groupID := range groupIDs {
+			org.GroupMembers[groupID] = append(org.GroupMembers[groupID], &OrganizationGroupMemberRow{OrganizationId: orgID, GroupId: groupID, SubjectId: u.SubjectClaims.Sub, SubjectType: subjectType})
+		}
+	}
+	return org, nil
+}
+
+func iamOrganizationUsersSubjectID(orgID string) string {
+	return fmt.Sprintf("group:organization:%s:users", orgID)
+}
+
+func iamFederationUsersSubjectID(federationID string) string {
+	return fmt.Sprintf("group:federation:%s:users", federationID)
+}
+
+// listIAMFolderScopes returns the folders of a cloud and the resources in them with their access
+// bindings. Resources that cannot be listed or whose access bindings cannot be read fail the query
+// unless iam_skip_inaccessible_resources is set; then they are logged and skipped.
+func listIAMFolderScopes(ctx context.Context, cfg *Config, tok string, rmClient ResourceManagerClient, cloudID string, folderID string, wantResources func(string) bool) ([]*IAMBindingScope, error) {
+	lockboxClient := NewLockboxClient(tok, 30, cfg)
+	serverlessClient := NewServerlessClient(tok, 30, cfg)
+	crClient := NewContainerRegistryClient(tok, 30, cfg)
+	skipInaccessible := cfg.IAMSkipInaccessibleResources != nil && *cfg.IAMSkipInaccessibleResources
+	resourceError := func(resource string, err error) error {
+		if !skipInaccessible {
+			return err
+		}
+		LogError(ctx, "listYandexIAMEffectivePermissions: skipping %s: %v", resource, err)
+		return nil
+	}
+
+	folders, err := collectPages(func(pageToken string) ([]*ResourceManagerFolder, string, error) {
+		return rmClient.ListFolders(ctx, cloudID, pageToken, 1000)
+	})
+	if err != nil {
+		return nil, err
+	}
+	var scopes []*IAMBindingScope
+	for _, folder := range folders {
+		if folderID != "" && folder.Id != folderID {
+			continue
+		}
+		bindings, err := collectPages(func(pageToken string) ([]*AccessBinding, string, error) {
+			return rmClient.ListFolderAccessBindings(ctx, folder.Id, pageToken, 1000)
+		})
+		if err != nil {
+			return nil, err
+		}
+		scopes = append(scopes, &IAMBindingScope{Level: IAMLevelFolder, ResourceType: IAMResourceFolder, ResourceId: folder.Id, ParentId: cloudID, CloudId: cloudID, FolderId: folder.Id, Bindings: bindings})
+		// addResource adds a resource of the folder with its access bindings.
+		addResource := func(resourceType string, id string, list func(pageToken string) ([]*AccessBinding, string, error)) error {
+			bindings, err := collectPages(list)
+			if err != nil {
+				return resourceError(fmt.Sprintf("access bindings of %s %s", resourceType, id), err)
+			}
+			scopes = append(scopes, &IAMBindingScope{Level: IAMLevelResource, ResourceType: resourceType, ResourceId: id, ParentId: folder.Id, CloudId: cloudID, FolderId: folder.Id, Bindings: bindings})
+			return nil
+		}
+
+		if wantResources(IAMResourceLockboxSec) {
+			secrets, err := collectPages(func(pageToken string) ([]*LockboxSecret, string, error) {
+				return lockboxClient.ListLockboxSecrets(ctx, folder.Id, pageToken, 1000)
+			})
+			if err != nil {
+				if err := resourceError("Lockbox secrets of folder "+folder.Id, err); err != nil {
+					return nil, err
+				}
+			}
+			for _, secret := range secrets {
+				if err := addResource(IAMResourceLockboxSec, secret.Id, func(pageToken string) ([]*AccessBinding, string, error) {
+					return lockboxClient.ListLockboxSecretAccessBindings(ctx, LockboxSecretID(secret.Id), pageToken, 1000)
+				}); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if wantResources(IAMResourceFunction) {
+			functions, err := listAllServerlessFunctions(ctx, serverlessClient, folder.Id)
+			if err != nil {
+				if err := resourceError("functions of folder "+folder.Id, err); err != nil {
+					return nil, err
+				}
+			}
+			for _, fn := range functions {
+				if err := addResource(IAMResourceFunction, fn.Id, func(pageToken string) ([]*AccessBinding, string, error) {
+					return serverlessClient.ListServerlessFunctionAccessBindings(ctx, ServerlessFunctionID(fn.Id), pageToken, 1000)
+				}); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if wantResources(IAMResourceCRRegistry) {
+			registries, err := listAllCRRegistries(ctx, crClient, folder.Id, "")
+			if err != nil {
+				if err := resourceError("container registries of folder "+folder.Id, err); err != nil {
+					return nil, err
+				}
+			}
+			for _, registry := range registries {
+				if err := addResource(IAMResourceCRRegistry, registry.Id, func(pageToken string) ([]*AccessBinding, string, error) {
+					return crClient.ListCRRegistryAccessBindings(ctx, CRRegistryID(registry.Id), pageToken, 1000)
+				}); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return scopes, nil
+}
+
+func listAllResourceManagerClouds(ctx context.Context, client ResourceManagerClient) ([]*ResourceManagerCloud, error) {
+	var all []*ResourceManagerCloud
+	pageToken := ""
+	pageSize := int64(1000)
+	for {
+		clouds, nextPageToken, err := client.ListClouds(ctx, "", pageToken, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, clouds...)
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return all, nil
+}
+
+// resolveEffectivePermissions expands the access bindings of every scope to the scope itself and
+// all scopes nested in it. Roles granted to a group, including the system groups of organization
+// and federation users, are listed for the group and for each of its members, and every role is
+// followed by the roles it implies. Roles granted to allUsers and allAuthenticatedUsers are listed
+// once for that subject and flagged as public access. Non-empty subjectID and roleID restrict the
+// rows to that subject and role before group members and implied roles are expanded.
+func resolveEffectivePermissions(scopes []*IAMBindingScope, groupMembers map[string][]*OrganizationGroupMemberRow, implied func(string) []string, subjectID string, roleID string) []*IAMEffectivePermissionRow {
+	byID := make(map[string]*IAMBindingScope, len(scopes))
+	for _, s := range scopes {
+		byID[s.ResourceId] = s
+	}
+	var rows []*IAMEffectivePermissionRow
+	for _, target := range scopes {
+		for granting := target; granting != nil; granting = byID[granting.ParentId] {
+			for _, b := range granting.Bindings {
+				var subjects []IAMEffectivePermissionRow
+				if subjectID == "" || b.Subject.Id == subjectID {
+					subjects = append(subjects, IAMEffectivePermissionRow{SubjectId: b.Subject.Id, SubjectType: b.Subject.Type})
+				}
+				for _, m := range groupMembers[b.Subject.Id] {
+					if subjectID == "" || m.SubjectId == subjectID {
+						subjects = append(subjects, IAMEffectivePermissionRow{SubjectId: m.SubjectId, SubjectType: m.SubjectType, ViaGroupId: b.Subject.Id})
+					}
+				}
+				if len(subjects) == 0 {
+					continue
+				}
+				roleIDs := append([]string{b.RoleId}, implied(b.RoleId)...)
+				base := IAMEffectivePermissionRow{
+					ResourceType:   target.ResourceType,
+					ResourceId:     target.ResourceId,
+					CloudId:        target.CloudId,
+					FolderId:       target.FolderId,
+					GrantedRoleId:  b.RoleId,
+					GrantedAtLevel: granting.Level,
+					GrantedOnId:    granting.ResourceId,
+					Inherited:      granting != target,
+					PublicAccess:   b.Subject.Type == IAMSubjectTypeSystem && (b.Subject.Id == IAMSubjectAllUsers || b.Subject.Id == IAMSubjectAllAuthenticatedUsers),
+				}
+				for i, id := range roleIDs {
+					if roleID != "" && id != roleID {
+						continue
+					}
+					for _, subject := range subjects {
+						row := base
+						row.SubjectId = subject.SubjectId
+						row.SubjectType = subject.SubjectType
+						row.ViaGroupId = subject.ViaGroupId
+						row.RoleId = id
+						row.Implied = i > 0
+						rows = append(rows, &row)
+					}
+				}
+			}
+		}
+	}
+	return rows
+}
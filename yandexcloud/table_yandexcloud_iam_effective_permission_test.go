@@ -0,0 +1,179 @@
+package yandexcloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/context_key"
+)
+
+func TestResolveEffectivePermissions(t *testing.T) {
+	binding := func(roleID, subjectID, subjectType string) *AccessBinding {
+		b := &AccessBinding{RoleId: roleID}
+		b.Subject.Id = subjectID
+		b.Subject.Type = subjectType
+		return b
+	}
+	scopes := []*IAMBindingScope{
+		{Level: IAMLevelOrganization, ResourceType: IAMResourceOrganization, ResourceId: "org1",
+			Bindings: []*AccessBinding{binding("viewer", "grp1", IAMSubjectTypeGroup)}},
+		{Level: IAMLevelCloud, ResourceType: IAMResourceCloud, ResourceId: "cloud1", ParentId: "org1", CloudId: "cloud1"},
+		{Level: IAMLevelFolder, ResourceType: IAMResourceFolder, ResourceId: "folder1", ParentId: "cloud1", CloudId: "cloud1", FolderId: "folder1",
+			Bindings: []*AccessBinding{binding("editor", "sa1", "serviceAccount")}},
+		{Level: IAMLevelResource, ResourceType: IAMResourceLockboxSec, ResourceId: "secret1", ParentId: "folder1", CloudId: "cloud1", FolderId: "folder1",
+			Bindings: []*AccessBinding{binding("lockbox.payloadViewer", "sa2", "serviceAccount")}},
+	}
+	groupMembers := map[string][]*OrganizationGroupMemberRow{
+		"grp1": {{GroupId: "grp1", SubjectId: "user1", SubjectType: "userAccount"}},
+	}
+	implied := func(roleID string) []string {
+		return iamImpliedRoleIDs(roleID, map[string]bool{"editor": true, "viewer": true})
+	}
+	rows := resolveEffectivePermissions(scopes, groupMembers, implied, "", "")
+
+	find := func(subjectID, resourceID, roleID string) *IAMEffectivePermissionRow {
+		for _, r := range rows {
+			if r.SubjectId == subjectID && r.ResourceId == resourceID && r.RoleId == roleID {
+				return r
+			}
+		}
+		return nil
+	}
+	if r := find("user1", "secret1", "viewer"); r == nil || r.ViaGroupId != "grp1" || r.GrantedAtLevel != IAMLevelOrganization || !r.Inherited || r.FolderId != "folder1" {
+		t.Errorf("unexpected group member row: %+v", r)
+	}
+	if r := find("grp1", "cloud1", "viewer"); r == nil || r.ViaGroupId != "" || r.SubjectType != IAMSubjectTypeGroup {
+		t.Errorf("unexpected group row: %+v", r)
+	}
+	if r := find("sa1", "secret1", "viewer"); r == nil || !r.Implied || r.GrantedRoleId != "editor" || r.GrantedOnId != "folder1" {
+		t.Errorf("unexpected implied row: %+v", r)
+	}
+	if r := find("sa1", "folder1", "editor"); r == nil || r.Inherited || r.Implied {
+		t.Errorf("unexpected direct row: %+v", r)
+	}
+	if r := find("sa2", "secret1", "lockbox.payloadViewer"); r == nil || r.GrantedAtLevel != IAMLevelResource {
+		t.Errorf("unexpected resource row: %+v", r)
+	}
+	if r := find("sa1", "cloud1", "editor"); r != nil {
+		t.Errorf("folder binding must not apply to its cloud: %+v", r)
+	}
+	// viewer on org1 reaches 4 scopes for the group and its member; editor on folder1 reaches
+	// 2 scopes with 2 roles; payloadViewer reaches 1 scope.
+	if len(rows) != 4*2+2*2+1 {
+		t.Errorf("unexpected number of rows: %d", len(rows))
+	}
+
+	// Subject and role filters are applied before group members and implied roles are expanded.
+	rows = resolveEffectivePermissions(scopes, groupMembers, implied, "user1", "viewer")
+	if len(rows) != 4 {
+		t.Errorf("unexpected number of filtered rows: %d", len(rows))
+	}
+	for _, r := range rows {
+		if r.SubjectId != "user1" || r.RoleId != "viewer" || r.ViaGroupId != "grp1" {
+			t.Errorf("unexpected filtered row: %+v", r)
+		}
+	}
+	rows = resolveEffectivePermissions(scopes, groupMembers, implied, "sa1", "viewer")
+	if len(rows) != 2 || !rows[0].Implied || rows[0].GrantedRoleId != "editor" {
+		t.Errorf("unexpected implied filtered rows: %+v", rows)
+	}
+}
+
+func TestResolveEffectivePermissionsSystemSubjects(t *testing.T) {
+	binding := func(roleID, subjectID string) *AccessBinding {
+		b := &AccessBinding{RoleId: roleID}
+		b.Subject.Id = subjectID
+		b.Subject.Type = IAMSubjectTypeSystem
+		return b
+	}
+	allOrgUsers := iamOrganizationUsersSubjectID("org1")
+	scopes := []*IAMBindingScope{
+		{Level: IAMLevelFolder, ResourceType: IAMResourceFolder, ResourceId: "folder1", FolderId: "folder1",
+			Bindings: []*AccessBinding{binding("viewer", allOrgUsers), binding("functions.functionInvoker", IAMSubjectAllUsers)}},
+	}
+	groupMembers := map[string][]*OrganizationGroupMemberRow{
+		allOrgUsers: {{GroupId: allOrgUsers, SubjectId: "user1", SubjectType: IAMSubjectTypeUserAccount}},
+	}
+	rows := resolveEffectivePermissions(scopes, groupMembers, func(string) []string { return nil }, "", "")
+	if len(rows) != 3 {
+		t.Fatalf("unexpected number of rows: %d", len(rows))
+	}
+	for _, r := range rows {
+		switch r.SubjectId {
+		case allOrgUsers:
+			if r.PublicAccess || r.ViaGroupId != "" {
+				t.Errorf("unexpected organization users row: %+v", r)
+			}
+		case "user1":
+			if r.PublicAccess || r.ViaGroupId != allOrgUsers || r.RoleId != "viewer" {
+				t.Errorf("unexpected organization member row: %+v", r)
+			}
+		case IAMSubjectAllUsers:
+			if !r.PublicAccess || r.RoleId != "functions.functionInvoker" {
+				t.Errorf("unexpected public row: %+v", r)
+			}
+		default:
+			t.Errorf("unexpected row: %+v", r)
+		}
+	}
+}
+
+// fakeIAMOrganizationClient serves the organization methods read by listIAMOrganizationScope.
+type fakeIAMOrganizationClient struct {
+	OrganizationClient
+	users      []*OrganizationUser
+	membersErr error
+}
+
+func (c *fakeIAMOrganizationClient) ListOrganizationAccessBindings(context.Context, string, string, int64) ([]*AccessBinding, string, error) {
+	return nil, "", nil
+}
+
+func (c *fakeIAMOrganizationClient) ListOrganizationGroups(context.Context, string, string, int64) ([]*OrganizationGroup, string, error) {
+	return []*OrganizationGroup{{Id: "grp1", Name: "admins"}}, "", nil
+}
+
+func (c *fakeIAMOrganizationClient) ListOrganizationGroupMembers(context.Context, string, string, int64) ([]*OrganizationGroupMember, string, error) {
+	if c.membersErr != nil {
+		return nil, "", c.membersErr
+	}
+	return []*OrganizationGroupMember{{SubjectId: "sa1", SubjectType: "serviceAccount"}}, "", nil
+}
+
+func (c *fakeIAMOrganizationClient) ListOrganizationUsers(context.Context, string, string, int64) ([]*OrganizationUser, string, error) {
+	return c.users, "", nil
+}
+
+func TestListIAMOrganizationScope(t *testing.T) {
+	var users []*OrganizationUser
+	raw := `[{"subjectClaims": {"sub": "user1"}}, {"subjectClaims": {"sub": "fed-user1", "federation": {"id": "fed1"}}}]`
+	if err := json.Unmarshal([]byte(raw), &users); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ctx := context.WithValue(context.Background(), context_key.Logger, hclog.NewNullLogger())
+	org, err := listIAMOrganizationScope(ctx, &fakeIAMOrganizationClient{users: users}, "org1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if org.Scope.ResourceId != "org1" || org.Scope.Level != IAMLevelOrganization {
+		t.Errorf("unexpected organization scope: %+v", org.Scope)
+	}
+	if m := org.GroupMembers["grp1"]; len(m) != 1 || m[0].SubjectId != "sa1" {
+		t.Errorf("unexpected group members: %+v", m)
+	}
+	if m := org.GroupMembers[iamOrganizationUsersSubjectID("org1")]; len(m) != 2 || m[0].SubjectType != IAMSubjectTypeUserAccount || m[1].SubjectType != IAMSubjectTypeFederatedUser {
+		t.Errorf("unexpected organization users: %+v", m)
+	}
+	if m := org.GroupMembers[iamFederationUsersSubjectID("fed1")]; len(m) != 1 || m[0].SubjectId != "fed-user1" {
+		t.Errorf("unexpected federation users: %+v", m)
+	}
+
+	// Group membership that cannot be read fails instead of silently dropping roles held through groups.
+	denied := errors.New("API error 403: permission denied")
+	if _, err := listIAMOrganizationScope(ctx, &fakeIAMOrganizationClient{users: users, membersErr: denied}, "org1"); !errors.Is(err, denied) {
+		t.Errorf("expected the group member error, got %v", err)
+	}
+}
@@ -0,0 +1,151 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// IAMRoleRow is a predefined role of the role catalog.
+type IAMRoleRow struct {
+	Id             string
+	Description    string
+	Service        string
+	IsPrimitive    bool
+	ImpliedRoleIds []string
+}
+
+// iamRoleLevels is the hierarchy of the primitive roles and of the roles of each service:
+// every role includes the permissions of the roles after it.
+var iamRoleLevels = []string{"admin", "editor", "viewer", "auditor"}
+
+func tableYandexIAMRole(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_iam_role",
+		Description: "Yandex Cloud IAM predefined roles.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"role_id", "service"}),
+			Hydrate:    listYandexIAMRoles,
+		},
+		Columns: []*plugin.Column{
+			{Name: "role_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "Role ID, e.g. editor or lockbox.payloadViewer."},
+			{Name: "description", Type: proto.ColumnType_STRING, Transform: transform.FromField("Description").Transform(transform.NullIfZeroValue), Description: "Role description."},
+			{Name: "service", Type: proto.ColumnType_STRING, Transform: transform.FromField("Service").Transform(transform.NullIfZeroValue), Description: "Service the role belongs to, e.g. lockbox; null for primitive roles."},
+			{Name: "is_primitive", Type: proto.ColumnType_BOOL, Transform: transform.FromField("IsPrimitive"), Description: "Whether the role is a primitive role such as admin, editor or viewer that applies to all services."},
+			{Name: "implied_role_ids", Type: proto.ColumnType_JSON, Transform: transform.FromField("ImpliedRoleIds"), Description: "Roles included in this role through the admin, editor, viewer, auditor hierarchy."},
+		},
+	}
+}
+
+func listYandexIAMRoles(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewIAMClient(tok, 30, cfg)
+
+	var filters []string
+	if id := getQualString(d, "role_id", nil); id != "" {
+		filters = append(filters, fmt.Sprintf("(id = \"%s\")", id))
+	}
+	if svc := getQualString(d, "service", nil); svc != "" {
+		filters = append(filters, fmt.Sprintf("(service = \"%s\")", svc))
+	}
+
+	roles, err := listAllIAMRoles(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	catalog := iamRoleCatalog(roles)
+	for _, role := range roles {
+		row := iamRoleRow(role, catalog)
+		if len(filters) > 0 {
+			if !iamRoleMatchesFilters(row, filters) {
+				continue
+			}
+		}
+		d.StreamListItem(ctx, row)
+	}
+	return nil, nil
+}
+
+func listAllIAMRoles(ctx context.Context, client IAMClient) ([]*IAMRole, error) {
+	var all []*IAMRole
+	pageToken := ""
+	pageSize := int64(1000)
+	for {
+		roles, nextPageToken, err := client.ListIAMRoles(ctx, pageToken, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, roles...)
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return all, nil
+}
+
+// iamRoleCatalog returns the set of role IDs of the catalog.
+func iamRoleCatalog(roles []*IAMRole) map[string]bool {
+	catalog := make(map[string]bool, len(roles))
+	for _, r := range roles {
+		catalog[r.Id] = true
+	}
+	return catalog
+}
+
+// iamRoleRow derives the service of a role from the prefix of its ID. Primitive roles have no prefix.
+func iamRoleRow(role *IAMRole, catalog map[string]bool) *IAMRoleRow {
+	row := &IAMRoleRow{
+		Id:             role.Id,
+		Description:    role.Description,
+		ImpliedRoleIds: iamImpliedRoleIDs(role.Id, catalog),
+	}
+	if i := strings.Index(role.Id, "."); i >= 0 {
+		row.Service = role.Id[:i]
+	} else {
+		row.IsPrimitive = true
+	}
+	return row
+}
+
+// iamImpliedRoleIDs returns the catalog roles included in roleID through the admin, editor, viewer,
+// auditor hierarchy, e.g. editor and viewer for admin or lockbox.viewer for lockbox.editor.
+func iamImpliedRoleIDs(roleID string, catalog map[string]bool) []string {
+	prefix, level := "", roleID
+	if i := strings.LastIndex(roleID, "."); i >= 0 {
+		prefix, level = roleID[:i+1], roleID[i+1:]
+	}
+	var implied []string
+	found := false
+	for _, l := range iamRoleLevels {
+		if found {
+			if id := prefix + l; catalog[id] {
+				implied = append(implied, id)
+			}
+		} else if l == level {
+			found = true
+		}
+	}
+	return implied
+}
+
+// Manual filtering, since the API does not support filters
+func iamRoleMatchesFilters(row *IAMRoleRow, filters []string) bool {
+	for _, f := range filters {
+		if strings.HasPrefix(f, "(id = ") && f != fmt.Sprintf("(id = \"%s\")", row.Id) {
+			return false
+		}
+		if strings.HasPrefix(f, "(service = ") && f != fmt.Sprintf("(service = \"%s\")", row.Service) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,53 @@
+package yandexcloud
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIAMImpliedRoleIDs(t *testing.T) {
+	catalog := map[string]bool{
+		"admin": true, "editor": true, "viewer": true, "auditor": true,
+		"lockbox.admin": true, "lockbox.editor": true, "lockbox.viewer": true,
+		"lockbox.payloadViewer": true,
+	}
+	cases := map[string][]string{
+		"admin":                 {"editor", "viewer", "auditor"},
+		"viewer":                {"auditor"},
+		"lockbox.admin":         {"lockbox.editor", "lockbox.viewer"},
+		"lockbox.viewer":        nil,
+		"lockbox.payloadViewer": nil,
+	}
+	for roleID, want := range cases {
+		if got := iamImpliedRoleIDs(roleID, catalog); !reflect.DeepEqual(got, want) {
+			t.Errorf("iamImpliedRoleIDs(%q) = %v, want %v", roleID, got, want)
+		}
+	}
+}
+
+func TestIAMRoleRow(t *testing.T) {
+	roles := []*IAMRole{
+		{Id: "admin", Description: "Full access"},
+		{Id: "editor"},
+		{Id: "resource-manager.clouds.owner"},
+		{Id: "lockbox.editor"},
+		{Id: "lockbox.viewer"},
+	}
+	catalog := iamRoleCatalog(roles)
+
+	row := iamRoleRow(roles[0], catalog)
+	if !row.IsPrimitive || row.Service != "" || row.Description != "Full access" || !reflect.DeepEqual(row.ImpliedRoleIds, []string{"editor"}) {
+		t.Errorf("unexpected primitive role: %+v", row)
+	}
+	row = iamRoleRow(roles[2], catalog)
+	if row.IsPrimitive || row.Service != "resource-manager" || row.ImpliedRoleIds != nil {
+		t.Errorf("unexpected service role: %+v", row)
+	}
+	row = iamRoleRow(roles[3], catalog)
+	if row.Service != "lockbox" || !reflect.DeepEqual(row.ImpliedRoleIds, []string{"lockbox.viewer"}) {
+		t.Errorf("unexpected service role: %+v", row)
+	}
+	if !iamRoleMatchesFilters(row, []string{`(service = "lockbox")`}) || iamRoleMatchesFilters(iamRoleRow(roles[0], catalog), []string{`(service = "lockbox")`}) {
+		t.Errorf("unexpected service filtering")
+	}
+}
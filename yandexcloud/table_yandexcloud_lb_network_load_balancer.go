@@ -0,0 +1,154 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+func tableYandexLBNetworkLoadBalancer(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_lb_network_load_balancer",
+		Description: "Yandex Cloud network load balancers.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "network_load_balancer_id", "name", "status"}),
+			Hydrate:    listYandexLBNetworkLoadBalancers,
+		},
+		Columns: []*plugin.Column{
+			{Name: "network_load_balancer_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "Network load balancer ID."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId"), Description: "Folder ID containing the load balancer."},
+			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name"), Description: "Load balancer name."},
+			{Name: "description", Type: proto.ColumnType_STRING, Transform: transform.FromField("Description"), Description: "Load balancer description."},
+			{Name: "created_at", Type: proto.ColumnType_STRING, Transform: transform.From(createdAtLBNetworkLoadBalancerDateTransform), Description: "Load balancer creation date (YYYY-MM-DD)."},
+			{Name: "labels", Type: proto.ColumnType_JSON, Transform: transform.FromField("Labels"), Description: "Resource labels as key:value pairs."},
+			{Name: "region_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("RegionId"), Description: "Region of the load balancer."},
+			{Name: "type", Type: proto.ColumnType_STRING, Transform: transform.FromField("Type"), Description: "Load balancer type (EXTERNAL/INTERNAL)."},
+			{Name: "status", Type: proto.ColumnType_STRING, Transform: transform.FromField("Status"), Description: "Load balancer status, e.g. ACTIVE or STOPPED."},
+			{Name: "session_affinity", Type: proto.ColumnType_STRING, Transform: transform.FromField("SessionAffinity"), Description: "Type of session affinity."},
+			{Name: "deletion_protection", Type: proto.ColumnType_BOOL, Transform: transform.FromField("DeletionProtection"), Description: "Whether deletion protection is enabled."},
+			{Name: "allow_zonal_shift", Type: proto.ColumnType_BOOL, Transform: transform.FromField("AllowZonalShift"), Description: "Whether zonal shift is allowed."},
+			{Name: "listeners", Type: proto.ColumnType_JSON, Transform: transform.FromField("Listeners"), Description: "Listeners with their ports, protocols and addresses."},
+			{Name: "listener_addresses", Type: proto.ColumnType_JSON, Transform: transform.From(nlbListenerAddressesTransform), Description: "Distinct addresses of the listeners."},
+			{Name: "attached_target_groups", Type: proto.ColumnType_JSON, Transform: transform.FromField("AttachedTargetGroups"), Description: "Attached target groups with their health checks."},
+			{Name: "target_group_ids", Type: proto.ColumnType_JSON, Transform: transform.From(nlbTargetGroupIDsTransform), Description: "IDs of the attached target groups."},
+		},
+	}
+}
+
+func listYandexLBNetworkLoadBalancers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewLoadBalancerClient(tok, 30, cfg)
+
+	var folderIDStr *string
+	if cfg.FolderID != nil {
+		str := string(*cfg.FolderID)
+		folderIDStr = &str
+	}
+	folderID := getQualString(d, "folder_id", folderIDStr)
+	if folderID == "" {
+		return nil, fmt.Errorf("folder_id must be provided")
+	}
+
+	var filters []string
+	if id := getQualString(d, "network_load_balancer_id", nil); id != "" {
+		filters = append(filters, fmt.Sprintf("(id = \"%s\")", id))
+	}
+	if n := getQualString(d, "name", nil); n != "" {
+		filters = append(filters, fmt.Sprintf("(name = \"%s\")", n))
+	}
+	if st := getQualString(d, "status", nil); st != "" {
+		filters = append(filters, fmt.Sprintf("(status = \"%s\")", st))
+	}
+
+	pageToken := ""
+	pageSize := int64(1000)
+	for {
+		balancers, nextPageToken, err := client.ListNetworkLoadBalancers(ctx, folderID, pageToken, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, nlb := range balancers {
+			if len(filters) > 0 {
+				if !lbNetworkLoadBalancerMatchesFilters(nlb, filters) {
+					continue
+				}
+			}
+			d.StreamListItem(ctx, nlb)
+		}
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return nil, nil
+}
+
+// Manual filtering, since the API does not support filters except folderId
+func lbNetworkLoadBalancerMatchesFilters(nlb *NetworkLoadBalancer, filters []string) bool {
+	for _, f := range filters {
+		if strings.HasPrefix(f, "(id = ") && !strings.Contains(f, nlb.Id) {
+			return false
+		}
+		if strings.HasPrefix(f, "(name = ") && !strings.Contains(f, nlb.Name) {
+			return false
+		}
+		if strings.HasPrefix(f, "(status = ") && !strings.Contains(f, nlb.Status) {
+			return false
+		}
+	}
+	return true
+}
+
+// Transform function for listener_addresses: distinct listener addresses in listener order
+func nlbListenerAddressesTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	nlb, ok := d.HydrateItem.(*NetworkLoadBalancer)
+	if !ok {
+		return nil, nil
+	}
+	seen := map[string]bool{}
+	addrs := []string{}
+	for _, l := range nlb.Listeners {
+		if l.Address == "" || seen[l.Address] {
+			continue
+		}
+		seen[l.Address] = true
+		addrs = append(addrs, l.Address)
+	}
+	return addrs, nil
+}
+
+// Transform function for target_group_ids
+func nlbTargetGroupIDsTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	nlb, ok := d.HydrateItem.(*NetworkLoadBalancer)
+	if !ok {
+		return nil, nil
+	}
+	ids := make([]string, 0, len(nlb.AttachedTargetGroups))
+	for _, tg := range nlb.AttachedTargetGroups {
+		ids = append(ids, tg.TargetGroupId)
+	}
+	return ids, nil
+}
+
+// Transform function for created_at: returns only the date (YYYY-MM-DD)
+func createdAtLBNetworkLoadBalancerDateTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if d.HydrateItem == nil {
+		return nil, nil
+	}
+	nlb, ok := d.HydrateItem.(*NetworkLoadBalancer)
+	if !ok || nlb.CreatedAt == "" {
+		return nil, nil
+	}
+	if len(nlb.CreatedAt) < 10 {
+		return nlb.CreatedAt, nil
+	}
+	return nlb.CreatedAt[:10], nil
+}
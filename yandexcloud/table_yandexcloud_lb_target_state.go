@@ -0,0 +1,114 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// LBTargetStateRow is the health of one target as seen by a network load balancer.
+type LBTargetStateRow struct {
+	NetworkLoadBalancerId   string
+	NetworkLoadBalancerName string
+	TargetGroupId           string
+	FolderId                string
+	SubnetId                string
+	Address                 string
+	Status                  string
+}
+
+func tableYandexLBTargetState(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_lb_target_state",
+		Description: "Health of network load balancer targets, one row per balancer, target group and target.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "network_load_balancer_id", "target_group_id", "status"}),
+			Hydrate:    listYandexLBTargetStates,
+		},
+		Columns: []*plugin.Column{
+			{Name: "network_load_balancer_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("NetworkLoadBalancerId"), Description: "Network load balancer ID."},
+			{Name: "network_load_balancer_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("NetworkLoadBalancerName"), Description: "Network load balancer name."},
+			{Name: "target_group_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("TargetGroupId"), Description: "Target group ID."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId"), Description: "Folder ID containing the load balancer."},
+			{Name: "subnet_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("SubnetId"), Description: "Subnet ID of the target."},
+			{Name: "address", Type: proto.ColumnType_INET, Transform: transform.FromField("Address"), Description: "IP address of the target."},
+			{Name: "status", Type: proto.ColumnType_STRING, Transform: transform.FromField("Status"), Description: "Target status (INITIAL/HEALTHY/UNHEALTHY/DRAINING/INACTIVE)."},
+		},
+	}
+}
+
+func listYandexLBTargetStates(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewLoadBalancerClient(tok, 30, cfg)
+
+	var folderIDStr *string
+	if cfg.FolderID != nil {
+		str := string(*cfg.FolderID)
+		folderIDStr = &str
+	}
+	folderID := getQualString(d, "folder_id", folderIDStr)
+	if folderID == "" {
+		return nil, fmt.Errorf("folder_id must be provided")
+	}
+	nlbID := getQualString(d, "network_load_balancer_id", nil)
+	targetGroupID := getQualString(d, "target_group_id", nil)
+	status := getQualString(d, "status", nil)
+
+	pageToken := ""
+	pageSize := int64(1000)
+	for {
+		balancers, nextPageToken, err := client.ListNetworkLoadBalancers(ctx, folderID, pageToken, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, nlb := range balancers {
+			if nlbID != "" && nlb.Id != nlbID {
+				continue
+			}
+			for _, atg := range nlb.AttachedTargetGroups {
+				if targetGroupID != "" && atg.TargetGroupId != targetGroupID {
+					continue
+				}
+				states, err := client.GetTargetStates(ctx, NetworkLoadBalancerID(nlb.Id), atg.TargetGroupId)
+				if err != nil {
+					return nil, err
+				}
+				for _, row := range lbTargetStateRows(nlb, atg.TargetGroupId, states) {
+					if status != "" && row.Status != status {
+						continue
+					}
+					d.StreamListItem(ctx, row)
+				}
+			}
+		}
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return nil, nil
+}
+
+// lbTargetStateRows maps the target states of a target group attached to a network load balancer to rows.
+func lbTargetStateRows(nlb *NetworkLoadBalancer, targetGroupID string, states []*TargetState) []*LBTargetStateRow {
+	rows := make([]*LBTargetStateRow, 0, len(states))
+	for _, st := range states {
+		rows = append(rows, &LBTargetStateRow{
+			NetworkLoadBalancerId:   nlb.Id,
+			NetworkLoadBalancerName: nlb.Name,
+			TargetGroupId:           targetGroupID,
+			FolderId:                nlb.FolderId,
+			SubnetId:                st.SubnetId,
+			Address:                 st.Address,
+			Status:                  st.Status,
+		})
+	}
+	return rows
+}
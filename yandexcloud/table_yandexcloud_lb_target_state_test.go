@@ -0,0 +1,42 @@
+package yandexcloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestLBTargetStateRows(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/load-balancer/v1/networkLoadBalancers/nlb1:getTargetStates" || r.URL.Query().Get("targetGroupId") != "tg1" {
+			t.Errorf("unexpected request: %s", r.URL)
+		}
+		if r.Header.Get("Authorization") != "Bearer tok" {
+			t.Errorf("unexpected authorization header: %q", r.Header.Get("Authorization"))
+		}
+		w.Write([]byte(`{"targetStates": [
+			{"subnetId": "subnet1", "address": "10.0.0.5", "status": "HEALTHY"},
+			{"subnetId": "subnet1", "address": "10.0.0.6", "status": "UNHEALTHY"}
+		]}`))
+	}))
+	defer srv.Close()
+	endpoint := EndpointOverride(srv.URL)
+	client := NewLoadBalancerClient("tok", 5, &Config{EndpointOverride: &endpoint})
+
+	states, err := client.GetTargetStates(context.Background(), "nlb1", "tg1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	nlb := &NetworkLoadBalancer{Id: "nlb1", Name: "web", FolderId: "folder1"}
+	rows := lbTargetStateRows(nlb, "tg1", states)
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(rows))
+	}
+	if r := rows[0]; r.NetworkLoadBalancerId != "nlb1" || r.NetworkLoadBalancerName != "web" || r.FolderId != "folder1" || r.TargetGroupId != "tg1" {
+		t.Errorf("unexpected balancer fields: %+v", r)
+	}
+	if r := rows[1]; r.SubnetId != "subnet1" || r.Address != "10.0.0.6" || r.Status != "UNHEALTHY" {
+		t.Errorf("unexpected target fields: %+v", r)
+	}
+}
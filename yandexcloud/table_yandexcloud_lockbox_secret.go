@@ -0,0 +1,161 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// LockboxSecretVersionStats summarizes all versions of a secret.
+type LockboxSecretVersionStats struct {
+	VersionCount int
+}
+
+func tableYandexLockboxSecret(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_lockbox_secret",
+		Description: "Yandex Cloud Lockbox secrets metadata. Secret payloads are never read.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "secret_id", "name", "status"}),
+			Hydrate:    listYandexLockboxSecrets,
+		},
+		Columns: []*plugin.Column{
+			{Name: "secret_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "Secret ID."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId"), Description: "Folder ID containing the secret."},
+			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name"), Description: "Secret name."},
+			{Name: "description", Type: proto.ColumnType_STRING, Transform: transform.FromField("Description"), Description: "Secret description."},
+			{Name: "created_at", Type: proto.ColumnType_STRING, Transform: transform.From(createdAtLockboxSecretDateTransform), Description: "Secret creation date (YYYY-MM-DD)."},
+			{Name: "labels", Type: proto.ColumnType_JSON, Transform: transform.FromField("Labels"), Description: "Resource labels as key:value pairs."},
+			{Name: "kms_key_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("KmsKeyId").Transform(transform.NullIfZeroValue), Description: "ID of the customer KMS key encrypting the secret; null if the default key is used."},
+			{Name: "status", Type: proto.ColumnType_STRING, Transform: transform.FromField("Status"), Description: "Secret status (CREATING/ACTIVE/INACTIVE)."},
+			{Name: "deletion_protection", Type: proto.ColumnType_BOOL, Transform: transform.FromField("DeletionProtection"), Description: "Whether deletion protection is enabled."},
+			{Name: "current_version_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("CurrentVersion.Id"), Description: "ID of the current version."},
+			{Name: "current_version_created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("CurrentVersion.CreatedAt").Transform(transform.NullIfZeroValue), Description: "Time the current version was created."},
+			{Name: "payload_entry_keys", Type: proto.ColumnType_JSON, Transform: transform.FromField("CurrentVersion.PayloadEntryKeys"), Description: "Keys of the payload entries of the current version. Values are never read."},
+			{Name: "password_payload_specification", Type: proto.ColumnType_JSON, Transform: transform.FromField("PasswordPayloadSpecification"), Description: "Settings of a generated password secret."},
+			{Name: "version_count", Type: proto.ColumnType_INT, Hydrate: getYandexLockboxSecretVersionStats, Transform: transform.FromField("VersionCount"), Description: "Number of versions of the secret; 1 means it was never rotated."},
+		},
+	}
+}
+
+func listYandexLockboxSecrets(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewLockboxClient(tok, 30, cfg)
+
+	var folderIDStr *string
+	if cfg.FolderID != nil {
+		str := string(*cfg.FolderID)
+		folderIDStr = &str
+	}
+	folderID := getQualString(d, "folder_id", folderIDStr)
+	if folderID == "" {
+		return nil, fmt.Errorf("folder_id must be provided")
+	}
+
+	var filters []string
+	if id := getQualString(d, "secret_id", nil); id != "" {
+		filters = append(filters, fmt.Sprintf("(id = \"%s\")", id))
+	}
+	if n := getQualString(d, "name", nil); n != "" {
+		filters = append(filters, fmt.Sprintf("(name = \"%s\")", n))
+	}
+	if st := getQualString(d, "status", nil); st != "" {
+		filters = append(filters, fmt.Sprintf("(status = \"%s\")", st))
+	}
+
+	pageToken := ""
+	pageSize := int64(1000)
+	for {
+		secrets, nextPageToken, err := client.ListLockboxSecrets(ctx, folderID, pageToken, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, secret := range secrets {
+			if len(filters) > 0 {
+				if !lockboxSecretMatchesFilters(secret, filters) {
+					continue
+				}
+			}
+			d.StreamListItem(ctx, secret)
+		}
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return nil, nil
+}
+
+func getYandexLockboxSecretVersionStats(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	secret, ok := h.Item.(*LockboxSecret)
+	if !ok {
+		return nil, nil
+	}
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewLockboxClient(tok, 30, cfg)
+	versions, err := listAllLockboxSecretVersions(ctx, client, LockboxSecretID(secret.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &LockboxSecretVersionStats{VersionCount: len(versions)}, nil
+}
+
+func listAllLockboxSecretVersions(ctx context.Context, client LockboxClient, secretID LockboxSecretID) ([]*LockboxSecretVersion, error) {
+	var versions []*LockboxSecretVersion
+	pageToken := ""
+	for {
+		page, nextPageToken, err := client.ListLockboxSecretVersions(ctx, secretID, pageToken, 1000)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, page...)
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return versions, nil
+}
+
+// Manual filtering, since the API does not support filters except folderId
+func lockboxSecretMatchesFilters(secret *LockboxSecret, filters []string) bool {
+	for _, f := range filters {
+		if strings.HasPrefix(f, "(id = ") && !strings.Contains(f, secret.Id) {
+			return false
+		}
+		if strings.HasPrefix(f, "(name = ") && !strings.Contains(f, secret.Name) {
+			return false
+		}
+		if strings.HasPrefix(f, "(status = ") && !strings.Contains(f, secret.Status) {
+			return false
+		}
+	}
+	return true
+}
+
+// Transform function for created_at: returns only the date (YYYY-MM-DD)
+func createdAtLockboxSecretDateTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if d.HydrateItem == nil {
+		return nil, nil
+	}
+	secret, ok := d.HydrateItem.(*LockboxSecret)
+	if !ok || secret.CreatedAt == "" {
+		return nil, nil
+	}
+	if len(secret.CreatedAt) < 10 {
+		return secret.CreatedAt, nil
+	}
+	return secret.CreatedAt[:10], nil
+}
@@ -0,0 +1,126 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// LockboxSecretVersionRow is a secret version together with the secret it belongs to.
+type LockboxSecretVersionRow struct {
+	Id               string
+	SecretId         string
+	SecretName       string
+	FolderId         string
+	KmsKeyId         string
+	Description      string
+	CreatedAt        string
+	DestroyAt        string
+	Status           string
+	IsCurrent        bool
+	PayloadEntryKeys []string
+}
+
+func tableYandexLockboxSecretVersion(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_lockbox_secret_version",
+		Description: "Yandex Cloud Lockbox secret versions metadata. Secret payloads are never read.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "secret_id", "status"}),
+			Hydrate:    listYandexLockboxSecretVersions,
+		},
+		Columns: []*plugin.Column{
+			{Name: "version_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "Version ID."},
+			{Name: "secret_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("SecretId"), Description: "Secret ID."},
+			{Name: "secret_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("SecretName"), Description: "Secret name."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId"), Description: "Folder ID containing the secret."},
+			{Name: "kms_key_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("KmsKeyId").Transform(transform.NullIfZeroValue), Description: "ID of the customer KMS key encrypting the secret."},
+			{Name: "description", Type: proto.ColumnType_STRING, Transform: transform.FromField("Description"), Description: "Version description."},
+			{Name: "created_at", Type: proto.ColumnType_STRING, Transform: transform.From(createdAtLockboxSecretVersionDateTransform), Description: "Version creation date (YYYY-MM-DD)."},
+			{Name: "destroy_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("DestroyAt").Transform(transform.NullIfZeroValue), Description: "Time the version is scheduled to be destroyed."},
+			{Name: "status", Type: proto.ColumnType_STRING, Transform: transform.FromField("Status"), Description: "Version status (ACTIVE/SCHEDULED_FOR_DESTRUCTION/DESTROYED)."},
+			{Name: "is_current", Type: proto.ColumnType_BOOL, Transform: transform.FromField("IsCurrent"), Description: "Whether this is the current version of the secret."},
+			{Name: "payload_entry_keys", Type: proto.ColumnType_JSON, Transform: transform.FromField("PayloadEntryKeys"), Description: "Keys of the payload entries. Values are never read."},
+		},
+	}
+}
+
+func listYandexLockboxSecretVersions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewLockboxClient(tok, 30, cfg)
+
+	var folderIDStr *string
+	if cfg.FolderID != nil {
+		str := string(*cfg.FolderID)
+		folderIDStr = &str
+	}
+	folderID := getQualString(d, "folder_id", folderIDStr)
+	if folderID == "" {
+		return nil, fmt.Errorf("folder_id must be provided")
+	}
+	secretID := getQualString(d, "secret_id", nil)
+	status := getQualString(d, "status", nil)
+
+	pageToken := ""
+	pageSize := int64(1000)
+	for {
+		secrets, nextPageToken, err := client.ListLockboxSecrets(ctx, folderID, pageToken, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, secret := range secrets {
+			if secretID != "" && secret.Id != secretID {
+				continue
+			}
+			versions, err := listAllLockboxSecretVersions(ctx, client, LockboxSecretID(secret.Id))
+			if err != nil {
+				return nil, err
+			}
+			for _, v := range versions {
+				if status != "" && v.Status != status {
+					continue
+				}
+				d.StreamListItem(ctx, &LockboxSecretVersionRow{
+					Id:               v.Id,
+					SecretId:         secret.Id,
+					SecretName:       secret.Name,
+					FolderId:         secret.FolderId,
+					KmsKeyId:         secret.KmsKeyId,
+					Description:      v.Description,
+					CreatedAt:        v.CreatedAt,
+					DestroyAt:        v.DestroyAt,
+					Status:           v.Status,
+					IsCurrent:        secret.CurrentVersion != nil && secret.CurrentVersion.Id == v.Id,
+					PayloadEntryKeys: v.PayloadEntryKeys,
+				})
+			}
+		}
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return nil, nil
+}
+
+// Transform function for created_at: returns only the date (YYYY-MM-DD)
+func createdAtLockboxSecretVersionDateTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if d.HydrateItem == nil {
+		return nil, nil
+	}
+	version, ok := d.HydrateItem.(*LockboxSecretVersionRow)
+	if !ok || version.CreatedAt == "" {
+		return nil, nil
+	}
+	if len(version.CreatedAt) < 10 {
+		return version.CreatedAt, nil
+	}
+	return version.CreatedAt[:10], nil
+}
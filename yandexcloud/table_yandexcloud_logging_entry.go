@@ -0,0 +1,123 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// LoggingEntryRow is a log entry together with the quals it was read with.
+type LoggingEntryRow struct {
+	LogGroupId string
+	Filter     string
+	Entry      *LoggingEntry
+}
+
+func tableYandexLoggingEntry(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_logging_entry",
+		Description: "Entries of a Yandex Cloud Logging log group, read for a time window.",
+		List: &plugin.ListConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "log_group_id", Require: plugin.Required},
+				{Name: "timestamp", Require: plugin.Required, Operators: []string{">", ">=", "=", "<", "<="}},
+				{Name: "resource_type", Require: plugin.Optional},
+				{Name: "resource_id", Require: plugin.Optional},
+				{Name: "level", Require: plugin.Optional},
+				{Name: "stream_name", Require: plugin.Optional},
+				{Name: "filter", Require: plugin.Optional},
+			},
+			Hydrate: listYandexLoggingEntries,
+		},
+		Columns: []*plugin.Column{
+			{Name: "log_group_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("LogGroupId"), Description: "Log group ID."},
+			{Name: "uid", Type: proto.ColumnType_STRING, Transform: transform.FromField("Entry.Uid"), Description: "Unique entry ID."},
+			{Name: "timestamp", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Entry.Timestamp"), Description: "Time of the entry."},
+			{Name: "ingested_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Entry.IngestedAt").Transform(transform.NullIfZeroValue), Description: "Time the entry was received by Cloud Logging."},
+			{Name: "saved_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Entry.SavedAt").Transform(transform.NullIfZeroValue), Description: "Time the entry was saved."},
+			{Name: "level", Type: proto.ColumnType_STRING, Transform: transform.FromField("Entry.Level"), Description: "Entry level (TRACE/DEBUG/INFO/WARN/ERROR/FATAL)."},
+			{Name: "message", Type: proto.ColumnType_STRING, Transform: transform.FromField("Entry.Message"), Description: "Entry message."},
+			{Name: "json_payload", Type: proto.ColumnType_JSON, Transform: transform.FromField("Entry.JsonPayload"), Description: "Structured payload of the entry."},
+			{Name: "resource_type", Type: proto.ColumnType_STRING, Transform: transform.FromField("Entry.Resource.Type").Transform(transform.NullIfZeroValue), Description: "Type of the resource that wrote the entry, e.g. serverless.function."},
+			{Name: "resource_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Entry.Resource.Id").Transform(transform.NullIfZeroValue), Description: "ID of the resource that wrote the entry."},
+			{Name: "stream_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Entry.StreamName").Transform(transform.NullIfZeroValue), Description: "Log stream name."},
+			{Name: "filter", Type: proto.ColumnType_STRING, Transform: transform.FromField("Filter").Transform(transform.NullIfZeroValue), Description: "Cloud Logging filter expression passed to the Read API, e.g. message: \"timeout\"."},
+		},
+	}
+}
+
+func listYandexLoggingEntries(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewLoggingClient(tok, 30, cfg)
+
+	from, to := getQualTimeRange(d, "timestamp")
+	if from.IsZero() {
+		return nil, fmt.Errorf("a lower bound on timestamp must be provided")
+	}
+	criteria := loggingReadCriteria(
+		getQualString(d, "log_group_id", nil),
+		from, to,
+		getQualString(d, "resource_type", nil),
+		getQualString(d, "resource_id", nil),
+		getQualString(d, "level", nil),
+		getQualString(d, "stream_name", nil),
+		getQualString(d, "filter", nil),
+	)
+
+	pageToken := ""
+	for {
+		entries, nextPageToken, err := client.ReadLoggingEntries(ctx, criteria, pageToken)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			d.StreamListItem(ctx, &LoggingEntryRow{LogGroupId: criteria.LogGroupId, Filter: criteria.Filter, Entry: entry})
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if nextPageToken == "" || len(entries) == 0 {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return nil, nil
+}
+
+// loggingReadCriteria builds the Read API criteria from the quals. A missing upper bound means now.
+// The API excludes the upper bound, so it is extended by a millisecond to keep <= and = quals inclusive.
+func loggingReadCriteria(logGroupID string, from time.Time, to time.Time, resourceType, resourceID, level, streamName, filter string) *LoggingReadCriteria {
+	if to.IsZero() {
+		to = time.Now()
+	} else {
+		to = to.Add(time.Millisecond)
+	}
+	criteria := &LoggingReadCriteria{
+		LogGroupId: logGroupID,
+		Since:      from.UTC().Format(time.RFC3339Nano),
+		Until:      to.UTC().Format(time.RFC3339Nano),
+		Filter:     filter,
+		PageSize:   1000,
+	}
+	if resourceType != "" {
+		criteria.ResourceTypes = []string{resourceType}
+	}
+	if resourceID != "" {
+		criteria.ResourceIds = []string{resourceID}
+	}
+	if level != "" {
+		criteria.Levels = []string{level}
+	}
+	if streamName != "" {
+		criteria.StreamNames = []string{streamName}
+	}
+	return criteria
+}
@@ -0,0 +1,67 @@
+package yandexcloud
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestLoggingReadCriteria(t *testing.T) {
+	from := time.Date(2024, 3, 5, 10, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 3, 5, 11, 0, 0, 0, time.UTC)
+	criteria := loggingReadCriteria("e23group", from, to, "serverless.function", "d4efn", "ERROR", "", `message: "timeout"`)
+	body, err := json.Marshal(loggingReadRequest{Criteria: criteria})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `{"criteria":{"logGroupId":"e23group","resourceTypes":["serverless.function"],"resourceIds":["d4efn"],"since":"2024-03-05T10:00:00Z","until":"2024-03-05T11:00:00.001Z","levels":["ERROR"],"filter":"message: \"timeout\"","pageSize":1000}}`
+	if string(body) != want {
+		t.Errorf("unexpected request body:\n got %s\nwant %s", body, want)
+	}
+
+	// An equality qual reads the single instant.
+	criteria = loggingReadCriteria("e23group", from, from, "", "", "", "", "")
+	if criteria.Since != "2024-03-05T10:00:00Z" || criteria.Until != "2024-03-05T10:00:00.001Z" {
+		t.Errorf("unexpected window: %s - %s", criteria.Since, criteria.Until)
+	}
+	if criteria.Levels != nil || criteria.ResourceIds != nil || criteria.Filter != "" {
+		t.Errorf("unexpected criteria: %+v", criteria)
+	}
+
+	// A missing upper bound reads up to now.
+	criteria = loggingReadCriteria("e23group", from, time.Time{}, "", "", "", "", "")
+	until, err := time.Parse(time.RFC3339Nano, criteria.Until)
+	if err != nil || time.Since(until) > time.Minute {
+		t.Errorf("expected upper bound near now, got %s", criteria.Until)
+	}
+}
+
+func TestLoggingEntryColumns(t *testing.T) {
+	var entry LoggingEntry
+	raw := `{"uid": "u1", "resource": {"type": "serverless.function", "id": "d4efn"}, "timestamp": "2024-03-05T10:00:00.123Z", "level": "ERROR", "message": "timeout", "jsonPayload": {"request_id": "r1"}}`
+	if err := json.Unmarshal([]byte(raw), &entry); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	table := tableYandexLoggingEntry(context.Background())
+	row := &LoggingEntryRow{LogGroupId: "e23group", Entry: &entry}
+	want := map[string]interface{}{
+		"log_group_id":  "e23group",
+		"uid":           "u1",
+		"timestamp":     "2024-03-05T10:00:00.123Z",
+		"level":         "ERROR",
+		"message":       "timeout",
+		"resource_type": "serverless.function",
+		"resource_id":   "d4efn",
+		"stream_name":   nil,
+		"filter":        nil,
+	}
+	for column, value := range want {
+		if got := columnValue(t, table, column, row); got != value {
+			t.Errorf("%s: got %v, want %v", column, got, value)
+		}
+	}
+	if payload, ok := columnValue(t, table, "json_payload", row).(map[string]interface{}); !ok || payload["request_id"] != "r1" {
+		t.Errorf("unexpected json_payload: %v", payload)
+	}
+}
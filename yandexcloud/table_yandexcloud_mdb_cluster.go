@@ -0,0 +1,429 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// mdbEngine describes a managed database service. The cluster and host tables of all engines
+// share one implementation and differ only by this descriptor.
+type mdbEngine struct {
+	// Name is the engine name used in table names and the engine column, e.g. mysql.
+	Name string
+	// Title is the human readable engine name used in descriptions.
+	Title string
+	// Service is the API path prefix of the service, e.g. managed-mysql.
+	Service string
+	// ResourcesPath is the path to the resources of data hosts inside the cluster config.
+	// A trailing "*" in an element matches a key with that prefix: the one for the cluster version,
+	// e.g. mongodb_6_0 for version 6.0, or otherwise the first one in sorted order.
+	ResourcesPath []string
+}
+
+var (
+	mdbEnginePostgreSQL = &mdbEngine{Name: "postgresql", Title: "PostgreSQL", Service: "managed-postgresql", ResourcesPath: []string{"resources"}}
+	mdbEngineMySQL      = &mdbEngine{Name: "mysql", Title: "MySQL", Service: "managed-mysql", ResourcesPath: []string{"resources"}}
+	mdbEngineClickHouse = &mdbEngine{Name: "clickhouse", Title: "ClickHouse", Service: "managed-clickhouse", ResourcesPath: []string{"clickhouse", "resources"}}
+	mdbEngineRedis      = &mdbEngine{Name: "redis", Title: "Redis (Valkey)", Service: "managed-redis", ResourcesPath: []string{"resources"}}
+	mdbEngineMongoDB    = &mdbEngine{Name: "mongodb", Title: "MongoDB", Service: "managed-mongodb", ResourcesPath: []string{"mongodb*", "mongod", "resources"}}
+	mdbEngineKafka      = &mdbEngine{Name: "kafka", Title: "Apache Kafka", Service: "managed-kafka", ResourcesPath: []string{"kafka", "resources"}}
+)
+
+// mdbEngines lists all managed database engines in the order they are queried by yandexcloud_mdb_host.
+var mdbEngines = []*mdbEngine{mdbEnginePostgreSQL, mdbEngineMySQL, mdbEngineClickHouse, mdbEngineRedis, mdbEngineMongoDB, mdbEngineKafka}
+
+func (e *mdbEngine) clusterTableName() string {
+	return fmt.Sprintf("yandexcloud_mdb_%s_cluster", e.Name)
+}
+
+func (e *mdbEngine) hostTableName() string {
+	return fmt.Sprintf("yandexcloud_mdb_%s_host", e.Name)
+}
+
+// mdbEngineForTable returns the engine of an engine-specific cluster or host table.
+func mdbEngineForTable(tableName string) *mdbEngine {
+	for _, e := range mdbEngines {
+		if tableName == e.clusterTableName() || tableName == e.hostTableName() {
+			return e
+		}
+	}
+	return nil
+}
+
+func mdbEngineByName(name string) *mdbEngine {
+	for _, e := range mdbEngines {
+		if e.Name == name {
+			return e
+		}
+	}
+	return nil
+}
+
+// MDBClusterRow is a managed database cluster in the schema shared by all engines.
+type MDBClusterRow struct {
+	Engine             string
+	Id                 string
+	FolderId           string
+	CreatedAt          string
+	Name               string
+	Description        string
+	Labels             map[string]string
+	Environment        string
+	Version            string
+	ResourcePresetId   string
+	DiskSize           int64
+	DiskTypeId         string
+	BackupWindowStart  string
+	NetworkId          string
+	Health             string
+	Status             string
+	SecurityGroupIds   []string
+	DeletionProtection bool
+	MaintenanceWindow  map[string]interface{}
+	Config             map[string]interface{}
+	// Kafka is only set for Managed Kafka clusters.
+	Kafka *MDBKafkaSettings
+}
+
+// MDBKafkaSettings are the Kafka-specific settings of a cluster config.
+type MDBKafkaSettings struct {
+	ZoneIds        []string
+	BrokersCount   int64
+	AssignPublicIp bool
+	SchemaRegistry bool
+	KafkaUiEnabled bool
+	RestApiEnabled bool
+}
+
+// MDBClusterHostInfo summarizes the hosts of a cluster.
+type MDBClusterHostInfo struct {
+	HostCount     int
+	PublicIpHosts []string
+}
+
+func tableYandexMDBPostgreSQLCluster(ctx context.Context) *plugin.Table {
+	return mdbClusterTable(ctx, mdbEnginePostgreSQL)
+}
+
+func tableYandexMDBMySQLCluster(ctx context.Context) *plugin.Table {
+	return mdbClusterTable(ctx, mdbEngineMySQL)
+}
+
+func tableYandexMDBClickHouseCluster(ctx context.Context) *plugin.Table {
+	return mdbClusterTable(ctx, mdbEngineClickHouse)
+}
+
+func tableYandexMDBRedisCluster(ctx context.Context) *plugin.Table {
+	return mdbClusterTable(ctx, mdbEngineRedis)
+}
+
+func tableYandexMDBMongoDBCluster(ctx context.Context) *plugin.Table {
+	return mdbClusterTable(ctx, mdbEngineMongoDB)
+}
+
+// tableYandexMDBKafkaCluster extends the shared cluster schema with the Kafka-specific settings.
+func tableYandexMDBKafkaCluster(ctx context.Context) *plugin.Table {
+	table := mdbClusterTable(ctx, mdbEngineKafka)
+	table.Columns = append(table.Columns,
+		&plugin.Column{Name: "zone_ids", Type: proto.ColumnType_JSON, Transform: transform.FromField("Kafka.ZoneIds"), Description: "Availability zones of the brokers."},
+		&plugin.Column{Name: "brokers_count", Type: proto.ColumnType_INT, Transform: transform.FromField("Kafka.BrokersCount"), Description: "Number of brokers per availability zone."},
+		&plugin.Column{Name: "assign_public_ip", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Kafka.AssignPublicIp"), Description: "Whether the brokers are reachable from the internet."},
+		&plugin.Column{Name: "schema_registry", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Kafka.SchemaRegistry"), Description: "Whether the managed schema registry is enabled."},
+		&plugin.Column{Name: "kafka_ui_enabled", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Kafka.KafkaUiEnabled"), Description: "Whether Kafka UI is enabled."},
+		&plugin.Column{Name: "rest_api_enabled", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Kafka.RestApiEnabled"), Description: "Whether the Kafka REST API is enabled."},
+	)
+	return table
+}
+
+func mdbClusterTable(_ context.Context, engine *mdbEngine) *plugin.Table {
+	return &plugin.Table{
+		Name:        engine.clusterTableName(),
+		Description: fmt.Sprintf("Yandex Cloud Managed Service for %s clusters.", engine.Title),
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "cluster_id", "name", "environment", "status"}),
+			Hydrate:    listYandexMDBClusters,
+		},
+		Columns: []*plugin.Column{
+			{Name: "cluster_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "Cluster ID."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId"), Description: "Folder ID containing the cluster."},
+			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name"), Description: "Cluster name."},
+			{Name: "description", Type: proto.ColumnType_STRING, Transform: transform.FromField("Description"), Description: "Cluster description."},
+			{Name: "created_at", Type: proto.ColumnType_STRING, Transform: transform.From(createdAtMDBClusterDateTransform), Description: "Cluster creation date (YYYY-MM-DD)."},
+			{Name: "labels", Type: proto.ColumnType_JSON, Transform: transform.FromField("Labels"), Description: "Resource labels as key:value pairs."},
+			{Name: "engine", Type: proto.ColumnType_STRING, Transform: transform.FromField("Engine"), Description: "Database engine."},
+			{Name: "environment", Type: proto.ColumnType_STRING, Transform: transform.FromField("Environment"), Description: "Deployment environment (PRODUCTION/PRESTABLE)."},
+			{Name: "version", Type: proto.ColumnType_STRING, Transform: transform.FromField("Version").Transform(transform.NullIfZeroValue), Description: "Database engine version."},
+			{Name: "resource_preset_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ResourcePresetId").Transform(transform.NullIfZeroValue), Description: "Resource preset of the data hosts, e.g. s3-c2-m8."},
+			{Name: "disk_size", Type: proto.ColumnType_INT, Transform: transform.FromField("DiskSize"), Description: "Disk size of a data host, in bytes."},
+			{Name: "disk_type_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("DiskTypeId").Transform(transform.NullIfZeroValue), Description: "Disk type of the data hosts."},
+			{Name: "backup_window_start", Type: proto.ColumnType_STRING, Transform: transform.FromField("BackupWindowStart").Transform(transform.NullIfZeroValue), Description: "Start of the daily backup window (HH:MM, UTC)."},
+			{Name: "network_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("NetworkId"), Description: "ID of the network the cluster belongs to."},
+			{Name: "health", Type: proto.ColumnType_STRING, Transform: transform.FromField("Health"), Description: "Aggregated cluster health (ALIVE/DEAD/DEGRADED/UNKNOWN)."},
+			{Name: "status", Type: proto.ColumnType_STRING, Transform: transform.FromField("Status"), Description: "Cluster status, e.g. RUNNING or STOPPED."},
+			{Name: "security_group_ids", Type: proto.ColumnType_JSON, Transform: transform.FromField("SecurityGroupIds"), Description: "IDs of the security groups of the cluster."},
+			{Name: "deletion_protection", Type: proto.ColumnType_BOOL, Transform: transform.FromField("DeletionProtection"), Description: "Whether deletion protection is enabled."},
+			{Name: "maintenance_window", Type: proto.ColumnType_JSON, Transform: transform.FromField("MaintenanceWindow"), Description: "Maintenance window of the cluster."},
+			{Name: "config", Type: proto.ColumnType_JSON, Transform: transform.FromField("Config"), Description: "Engine-specific cluster configuration."},
+			{Name: "host_count", Type: proto.ColumnType_INT, Hydrate: getYandexMDBClusterHostInfo, Transform: transform.FromField("HostCount"), Description: "Number of hosts in the cluster."},
+			{Name: "public_ip_hosts", Type: proto.ColumnType_JSON, Hydrate: getYandexMDBClusterHostInfo, Transform: transform.FromField("PublicIpHosts"), Description: "Names of the hosts with a public IP address."},
+		},
+	}
+}
+
+func listYandexMDBClusters(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	engine := mdbEngineForTable(d.Table.Name)
+	if engine == nil {
+		return nil, fmt.Errorf("unknown managed database table %s", d.Table.Name)
+	}
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewMDBClient(tok, 30, cfg)
+
+	var folderIDStr *string
+	if cfg.FolderID != nil {
+		str := string(*cfg.FolderID)
+		folderIDStr = &str
+	}
+	folderID := getQualString(d, "folder_id", folderIDStr)
+	if folderID == "" {
+		return nil, fmt.Errorf("folder_id must be provided")
+	}
+
+	// The API filters clusters by name; the other quals are matched below.
+	var nameFilter string
+	var filters []string
+	if id := getQualString(d, "cluster_id", nil); id != "" {
+		filters = append(filters, fmt.Sprintf("(id = \"%s\")", id))
+	}
+	if n := getQualString(d, "name", nil); n != "" {
+		nameFilter = fmt.Sprintf("name = %q", n)
+	}
+	if env := getQualString(d, "environment", nil); env != "" {
+		filters = append(filters, fmt.Sprintf("(environment = \"%s\")", env))
+	}
+	if st := getQualString(d, "status", nil); st != "" {
+		filters = append(filters, fmt.Sprintf("(status = \"%s\")", st))
+	}
+
+	pageToken := ""
+	pageSize := int64(1000)
+	for {
+		clusters, nextPageToken, err := client.ListMDBClusters(ctx, engine.Service, folderID, nameFilter, pageToken, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range clusters {
+			if len(filters) > 0 {
+				if !mdbClusterMatchesFilters(c, filters) {
+					continue
+				}
+			}
+			d.StreamListItem(ctx, mdbClusterRow(engine, c))
+		}
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return nil, nil
+}
+
+// getYandexMDBClusterHostInfo lists the hosts of a cluster; it is only called when host_count or
+// public_ip_hosts is selected.
+func getYandexMDBClusterHostInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	row, ok := h.Item.(*MDBClusterRow)
+	if !ok {
+		return &MDBClusterHostInfo{}, nil
+	}
+	engine := mdbEngineByName(row.Engine)
+	if engine == nil {
+		return &MDBClusterHostInfo{}, nil
+	}
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewMDBClient(tok, 30, cfg)
+	hosts, err := listAllMDBHosts(ctx, client, engine, MDBClusterID(row.Id))
+	if err != nil {
+		return nil, err
+	}
+	info := &MDBClusterHostInfo{HostCount: len(hosts), PublicIpHosts: []string{}}
+	for _, host := range hosts {
+		if host.AssignPublicIp {
+			info.PublicIpHosts = append(info.PublicIpHosts, host.Name)
+		}
+	}
+	return info, nil
+}
+
+// mdbClusterRow maps a cluster of any engine to the shared schema.
+func mdbClusterRow(engine *mdbEngine, c *MDBCluster) *MDBClusterRow {
+	row := &MDBClusterRow{
+		Engine:             engine.Name,
+		Id:                 c.Id,
+		FolderId:           c.FolderId,
+		CreatedAt:          c.CreatedAt,
+		Name:               c.Name,
+		Description:        c.Description,
+		Labels:             c.Labels,
+		Environment:        c.Environment,
+		NetworkId:          c.NetworkId,
+		Health:             c.Health,
+		Status:             c.Status,
+		SecurityGroupIds:   c.SecurityGroupIds,
+		DeletionProtection: c.DeletionProtection,
+		MaintenanceWindow:  c.MaintenanceWindow,
+		Config:             c.Config,
+	}
+	if row.SecurityGroupIds == nil {
+		row.SecurityGroupIds = []string{}
+	}
+	row.Version, _ = c.Config["version"].(string)
+	if resources := mdbConfigLookup(c.Config, engine.ResourcesPath); resources != nil {
+		row.ResourcePresetId, _ = resources["resourcePresetId"].(string)
+		row.DiskTypeId, _ = resources["diskTypeId"].(string)
+		if size, ok := resources["diskSize"].(string); ok {
+			row.DiskSize, _ = strconv.ParseInt(size, 10, 64)
+		}
+	}
+	if engine == mdbEngineKafka {
+		row.Kafka = mdbKafkaSettings(c.Config)
+	}
+	if window, ok := c.Config["backupWindowStart"].(map[string]interface{}); ok {
+		hours, _ := window["hours"].(float64)
+		minutes, _ := window["minutes"].(float64)
+		row.BackupWindowStart = fmt.Sprintf("%02d:%02d", int(hours), int(minutes))
+	}
+	return row
+}
+
+func mdbKafkaSettings(config map[string]interface{}) *MDBKafkaSettings {
+	settings := &MDBKafkaSettings{ZoneIds: []string{}}
+	if zones, ok := config["zoneId"].([]interface{}); ok {
+		for _, z := range zones {
+			if zone, ok := z.(string); ok {
+				settings.ZoneIds = append(settings.ZoneIds, zone)
+			}
+		}
+	}
+	if count, ok := config["brokersCount"].(string); ok {
+		settings.BrokersCount, _ = strconv.ParseInt(count, 10, 64)
+	}
+	settings.AssignPublicIp, _ = config["assignPublicIp"].(bool)
+	settings.SchemaRegistry, _ = config["schemaRegistry"].(bool)
+	if ui := mdbConfigLookup(config, []string{"kafkaUiConfig"}); ui != nil {
+		settings.KafkaUiEnabled, _ = ui["enabled"].(bool)
+	}
+	if rest := mdbConfigLookup(config, []string{"restApiConfig"}); rest != nil {
+		settings.RestApiEnabled, _ = rest["enabled"].(bool)
+	}
+	return settings
+}
+
+// mdbConfigLookup walks path through nested config objects and returns the object at its end.
+func mdbConfigLookup(config map[string]interface{}, path []string) map[string]interface{} {
+	version, _ := config["version"].(string)
+	current := config
+	for _, key := range path {
+		var next interface{}
+		if prefix, ok := strings.CutSuffix(key, "*"); ok {
+			next = current[mdbConfigVersionKey(current, prefix, version)]
+		} else {
+			next = current[key]
+		}
+		m, ok := next.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		current = m
+	}
+	return current
+}
+
+// mdbConfigVersionKey returns the key with the given prefix that holds the config of the cluster
+// version, e.g. mongodb_6_0 for version 6.0, or the first such key in sorted order.
+func mdbConfigVersionKey(config map[string]interface{}, prefix string, version string) string {
+	if version != "" {
+		key := prefix + "_" + strings.ReplaceAll(version, ".", "_")
+		if _, ok := config[key]; ok {
+			return key
+		}
+	}
+	var keys []string
+	for k := range config {
+		if strings.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) == 0 {
+		return ""
+	}
+	sort.Strings(keys)
+	return keys[0]
+}
+
+// Manual filtering for the quals the API cannot filter on
+func mdbClusterMatchesFilters(c *MDBCluster, filters []string) bool {
+	for _, f := range filters {
+		if strings.HasPrefix(f, "(id = ") && !strings.Contains(f, c.Id) {
+			return false
+		}
+		if strings.HasPrefix(f, "(environment = ") && !strings.Contains(f, c.Environment) {
+			return false
+		}
+		if strings.HasPrefix(f, "(status = ") && !strings.Contains(f, c.Status) {
+			return false
+		}
+	}
+	return true
+}
+
+// Transform function for created_at: returns only the date (YYYY-MM-DD)
+func createdAtMDBClusterDateTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if d.HydrateItem == nil {
+		return nil, nil
+	}
+	c, ok := d.HydrateItem.(*MDBClusterRow)
+	if !ok || c.CreatedAt == "" {
+		return nil, nil
+	}
+	if len(c.CreatedAt) < 10 {
+		return c.CreatedAt, nil
+	}
+	return c.CreatedAt[:10], nil
+}
+
+// listAllMDBClusters returns the clusters of an engine in a folder, or only the given one if clusterID is set.
+func listAllMDBClusters(ctx context.Context, client MDBClient, engine *mdbEngine, folderID string, clusterID string) ([]*MDBCluster, error) {
+	var clusters []*MDBCluster
+	pageToken := ""
+	for {
+		page, nextPageToken, err := client.ListMDBClusters(ctx, engine.Service, folderID, "", pageToken, 1000)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range page {
+			if clusterID != "" && c.Id != clusterID {
+				continue
+			}
+			clusters = append(clusters, c)
+		}
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return clusters, nil
+}
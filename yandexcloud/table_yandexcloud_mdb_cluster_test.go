@@ -0,0 +1,65 @@
+package yandexcloud
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMDBClusterRow(t *testing.T) {
+	cases := []struct {
+		engine  *mdbEngine
+		raw     string
+		version string
+		preset  string
+		size    int64
+		backup  string
+	}{
+		{
+			engine:  mdbEngineMySQL,
+			raw:     `{"id": "c1", "config": {"version": "8.0", "resources": {"resourcePresetId": "s3-c2-m8", "diskSize": "10737418240", "diskTypeId": "network-ssd"}, "backupWindowStart": {"hours": 22, "minutes": 5}}}`,
+			version: "8.0", preset: "s3-c2-m8", size: 10737418240, backup: "22:05",
+		},
+		{
+			engine:  mdbEngineClickHouse,
+			raw:     `{"id": "c2", "config": {"version": "24.3", "clickhouse": {"resources": {"resourcePresetId": "s3-c4-m16", "diskSize": "34359738368"}}, "zookeeper": {"resources": {"resourcePresetId": "s3-c2-m8"}}}}`,
+			version: "24.3", preset: "s3-c4-m16", size: 34359738368,
+		},
+		{
+			engine:  mdbEngineMongoDB,
+			raw:     `{"id": "c3", "config": {"version": "6.0", "mongodb_6_0": {"mongod": {"resources": {"resourcePresetId": "s3-c2-m8", "diskSize": "21474836480"}}}, "backupWindowStart": {"hours": 3}}}`,
+			version: "6.0", preset: "s3-c2-m8", size: 21474836480, backup: "03:00",
+		},
+		{
+			engine:  mdbEngineMongoDB,
+			raw:     `{"id": "c5", "config": {"version": "6.0", "mongodb_4_4": {"mongod": {"resources": {"resourcePresetId": "s2.micro"}}}, "mongodb_6_0": {"mongod": {"resources": {"resourcePresetId": "s3-c4-m16"}}}, "mongodb_7_0": {"mongod": {"resources": {"resourcePresetId": "s3-c8-m32"}}}}}`,
+			version: "6.0", preset: "s3-c4-m16",
+		},
+		{
+			engine:  mdbEngineRedis,
+			raw:     `{"id": "c4", "config": {"version": "7.2-valkey"}}`,
+			version: "7.2-valkey",
+		},
+	}
+	for _, tc := range cases {
+		var c MDBCluster
+		if err := json.Unmarshal([]byte(tc.raw), &c); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		row := mdbClusterRow(tc.engine, &c)
+		if row.Engine != tc.engine.Name || row.Version != tc.version || row.ResourcePresetId != tc.preset || row.DiskSize != tc.size || row.BackupWindowStart != tc.backup {
+			t.Errorf("%s: unexpected row: %+v", tc.engine.Name, row)
+		}
+	}
+}
+
+func TestMDBEngineForTable(t *testing.T) {
+	if e := mdbEngineForTable("yandexcloud_mdb_mysql_host"); e != mdbEngineMySQL {
+		t.Errorf("expected mysql engine, got %+v", e)
+	}
+	if e := mdbEngineForTable("yandexcloud_mdb_clickhouse_cluster"); e != mdbEngineClickHouse {
+		t.Errorf("expected clickhouse engine, got %+v", e)
+	}
+	if e := mdbEngineForTable("yandexcloud_mdb_host"); e != nil {
+		t.Errorf("expected no engine for the cross-engine table, got %+v", e)
+	}
+}
@@ -0,0 +1,212 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// MDBHostRow is a managed database host together with its cluster.
+type MDBHostRow struct {
+	Engine            string
+	Name              string
+	ClusterId         string
+	ClusterName       string
+	FolderId          string
+	Environment       string
+	ZoneId            string
+	SubnetId          string
+	Role              string
+	Health            string
+	Type              string
+	ShardName         string
+	ReplicationSource string
+	AssignPublicIp    bool
+	ResourcePresetId  string
+	DiskSize          int64
+	DiskTypeId        string
+	SecurityGroupIds  []string
+	Services          []map[string]interface{}
+}
+
+func tableYandexMDBPostgreSQLHost(ctx context.Context) *plugin.Table {
+	return mdbHostTable(ctx, mdbEnginePostgreSQL)
+}
+
+func tableYandexMDBMySQLHost(ctx context.Context) *plugin.Table {
+	return mdbHostTable(ctx, mdbEngineMySQL)
+}
+
+func tableYandexMDBClickHouseHost(ctx context.Context) *plugin.Table {
+	return mdbHostTable(ctx, mdbEngineClickHouse)
+}
+
+func tableYandexMDBRedisHost(ctx context.Context) *plugin.Table {
+	return mdbHostTable(ctx, mdbEngineRedis)
+}
+
+func tableYandexMDBMongoDBHost(ctx context.Context) *plugin.Table {
+	return mdbHostTable(ctx, mdbEngineMongoDB)
+}
+
+func tableYandexMDBKafkaHost(ctx context.Context) *plugin.Table {
+	return mdbHostTable(ctx, mdbEngineKafka)
+}
+
+func mdbHostTable(_ context.Context, engine *mdbEngine) *plugin.Table {
+	return &plugin.Table{
+		Name:        engine.hostTableName(),
+		Description: fmt.Sprintf("Yandex Cloud Managed Service for %s hosts.", engine.Title),
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "cluster_id"}),
+			Hydrate:    listYandexMDBHosts,
+		},
+		Columns: mdbHostColumns(),
+	}
+}
+
+// tableYandexMDBHost lists the hosts of all managed database engines.
+func tableYandexMDBHost(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_mdb_host",
+		Description: "Yandex Cloud managed database hosts of all engines.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "cluster_id", "engine"}),
+			Hydrate:    listYandexMDBHosts,
+		},
+		Columns: mdbHostColumns(),
+	}
+}
+
+func mdbHostColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name"), Description: "Host name (FQDN)."},
+		{Name: "engine", Type: proto.ColumnType_STRING, Transform: transform.FromField("Engine"), Description: "Database engine (postgresql/mysql/clickhouse/redis/mongodb/kafka)."},
+		{Name: "cluster_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ClusterId"), Description: "Cluster ID."},
+		{Name: "cluster_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("ClusterName"), Description: "Cluster name."},
+		{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId"), Description: "Folder ID containing the cluster."},
+		{Name: "environment", Type: proto.ColumnType_STRING, Transform: transform.FromField("Environment"), Description: "Deployment environment of the cluster (PRODUCTION/PRESTABLE)."},
+		{Name: "zone_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ZoneId"), Description: "Availability zone of the host."},
+		{Name: "subnet_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("SubnetId"), Description: "ID of the subnet the host belongs to."},
+		{Name: "role", Type: proto.ColumnType_STRING, Transform: transform.FromField("Role").Transform(transform.NullIfZeroValue), Description: "Host role, e.g. MASTER, REPLICA or KAFKA."},
+		{Name: "health", Type: proto.ColumnType_STRING, Transform: transform.FromField("Health"), Description: "Host health (ALIVE/DEAD/DEGRADED/UNKNOWN)."},
+		{Name: "type", Type: proto.ColumnType_STRING, Transform: transform.FromField("Type").Transform(transform.NullIfZeroValue), Description: "Host type for multi-component engines, e.g. ZOOKEEPER or MONGOS."},
+		{Name: "shard_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("ShardName").Transform(transform.NullIfZeroValue), Description: "Name of the shard the host belongs to."},
+		{Name: "replication_source", Type: proto.ColumnType_STRING, Transform: transform.FromField("ReplicationSource").Transform(transform.NullIfZeroValue), Description: "Host the replica streams from, if set explicitly."},
+		{Name: "assign_public_ip", Type: proto.ColumnType_BOOL, Transform: transform.FromField("AssignPublicIp"), Description: "Whether the host has a public IP address."},
+		{Name: "resource_preset_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ResourcePresetId").Transform(transform.NullIfZeroValue), Description: "Resource preset of the host."},
+		{Name: "disk_size", Type: proto.ColumnType_INT, Transform: transform.FromField("DiskSize"), Description: "Disk size of the host, in bytes."},
+		{Name: "disk_type_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("DiskTypeId").Transform(transform.NullIfZeroValue), Description: "Disk type of the host."},
+		{Name: "security_group_ids", Type: proto.ColumnType_JSON, Transform: transform.FromField("SecurityGroupIds"), Description: "IDs of the security groups of the cluster."},
+		{Name: "services", Type: proto.ColumnType_JSON, Transform: transform.FromField("Services"), Description: "Services running on the host with their health."},
+	}
+}
+
+func listYandexMDBHosts(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	engines := mdbEngines
+	if engine := mdbEngineForTable(d.Table.Name); engine != nil {
+		engines = []*mdbEngine{engine}
+	} else if name := getQualString(d, "engine", nil); name != "" {
+		engine := mdbEngineByName(name)
+		if engine == nil {
+			return nil, nil
+		}
+		engines = []*mdbEngine{engine}
+	}
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewMDBClient(tok, 30, cfg)
+
+	var folderIDStr *string
+	if cfg.FolderID != nil {
+		str := string(*cfg.FolderID)
+		folderIDStr = &str
+	}
+	folderID := getQualString(d, "folder_id", folderIDStr)
+	if folderID == "" {
+		return nil, fmt.Errorf("folder_id must be provided")
+	}
+	clusterID := getQualString(d, "cluster_id", nil)
+
+	// When several engines are queried, an engine that fails, e.g. because the service is not
+	// available to the caller, is skipped so that the hosts of the other engines are still listed.
+	skipErrors := len(engines) > 1
+	for _, engine := range engines {
+		clusters, err := listAllMDBClusters(ctx, client, engine, folderID, clusterID)
+		if err != nil {
+			if skipErrors {
+				LogError(ctx, "listYandexMDBHosts: skipping %s clusters: %v", engine.Name, err)
+				continue
+			}
+			return nil, err
+		}
+		for _, c := range clusters {
+			hosts, err := listAllMDBHosts(ctx, client, engine, MDBClusterID(c.Id))
+			if err != nil {
+				if skipErrors {
+					LogError(ctx, "listYandexMDBHosts: skipping hosts of %s cluster %s: %v", engine.Name, c.Id, err)
+					continue
+				}
+				return nil, err
+			}
+			for _, host := range hosts {
+				d.StreamListItem(ctx, mdbHostRow(engine, c, host))
+			}
+		}
+	}
+	return nil, nil
+}
+
+// listAllMDBHosts returns all hosts of a cluster.
+func listAllMDBHosts(ctx context.Context, client MDBClient, engine *mdbEngine, clusterID MDBClusterID) ([]*MDBHost, error) {
+	var hosts []*MDBHost
+	pageToken := ""
+	for {
+		page, nextPageToken, err := client.ListMDBHosts(ctx, engine.Service, clusterID, pageToken, 1000)
+		if err != nil {
+			return nil, err
+		}
+		hosts = append(hosts, page...)
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return hosts, nil
+}
+
+func mdbHostRow(engine *mdbEngine, c *MDBCluster, host *MDBHost) *MDBHostRow {
+	row := &MDBHostRow{
+		Engine:            engine.Name,
+		Name:              host.Name,
+		ClusterId:         c.Id,
+		ClusterName:       c.Name,
+		FolderId:          c.FolderId,
+		Environment:       c.Environment,
+		ZoneId:            host.ZoneId,
+		SubnetId:          host.SubnetId,
+		Role:              host.Role,
+		Health:            host.Health,
+		Type:              host.Type,
+		ShardName:         host.ShardName,
+		ReplicationSource: host.ReplicationSource,
+		AssignPublicIp:    host.AssignPublicIp,
+		SecurityGroupIds:  c.SecurityGroupIds,
+		Services:          host.Services,
+	}
+	if row.SecurityGroupIds == nil {
+		row.SecurityGroupIds = []string{}
+	}
+	if host.Resources != nil {
+		row.ResourcePresetId = host.Resources.ResourcePresetId
+		row.DiskSize = host.Resources.DiskSize
+		row.DiskTypeId = host.Resources.DiskTypeId
+	}
+	return row
+}
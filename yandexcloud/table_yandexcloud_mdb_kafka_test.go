@@ -0,0 +1,59 @@
+package yandexcloud
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMDBKafkaTopicRow(t *testing.T) {
+	raw := `{"name": "orders", "clusterId": "c1", "partitions": "6", "replicationFactor": "3",
+		"topicConfig_3": {"cleanupPolicy": "CLEANUP_POLICY_DELETE", "retentionMs": "604800000", "minInsyncReplicas": "2"}}`
+	var topic KafkaTopic
+	if err := json.Unmarshal([]byte(raw), &topic); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	row := mdbKafkaTopicRow(&MDBCluster{Id: "c1", Name: "events"}, &topic)
+	if row.Partitions != 6 || row.ReplicationFactor != 3 || row.CleanupPolicy != "CLEANUP_POLICY_DELETE" {
+		t.Errorf("unexpected topic row: %+v", row)
+	}
+	if row.RetentionMs == nil || *row.RetentionMs != 604800000 {
+		t.Errorf("unexpected retention: %v", row.RetentionMs)
+	}
+	if row.RetentionBytes != nil {
+		t.Errorf("expected inherited retention bytes, got %d", *row.RetentionBytes)
+	}
+	if row.MinInsyncReplicas == nil || *row.MinInsyncReplicas != 2 {
+		t.Errorf("unexpected min insync replicas: %v", row.MinInsyncReplicas)
+	}
+}
+
+func TestMDBKafkaUserRow(t *testing.T) {
+	user := &KafkaUser{Name: "etl", Permissions: []KafkaUserPermission{
+		{TopicName: "orders", Role: KafkaAccessRoleConsumer},
+		{TopicName: "*", Role: KafkaAccessRoleProducer},
+		{TopicName: "audit", Role: KafkaAccessRoleTopicAdmin},
+	}}
+	row := mdbKafkaUserRow(&MDBCluster{Id: "c1"}, user)
+	if !row.WildcardProduce || row.WildcardConsume {
+		t.Errorf("unexpected wildcard flags: %+v", row)
+	}
+	if len(row.ProduceTopics) != 2 || len(row.ConsumeTopics) != 2 {
+		t.Errorf("unexpected topics: produce %v, consume %v", row.ProduceTopics, row.ConsumeTopics)
+	}
+}
+
+func TestMDBKafkaSettings(t *testing.T) {
+	var c MDBCluster
+	raw := `{"id": "c1", "config": {"version": "3.6", "zoneId": ["ru-central1-a", "ru-central1-b"], "brokersCount": "2", "assignPublicIp": true, "kafkaUiConfig": {"enabled": true},
+		"kafka": {"resources": {"resourcePresetId": "s3-c2-m8", "diskSize": "107374182400"}}}}`
+	if err := json.Unmarshal([]byte(raw), &c); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	row := mdbClusterRow(mdbEngineKafka, &c)
+	if row.Kafka == nil || len(row.Kafka.ZoneIds) != 2 || row.Kafka.BrokersCount != 2 || !row.Kafka.AssignPublicIp || !row.Kafka.KafkaUiEnabled || row.Kafka.SchemaRegistry {
+		t.Errorf("unexpected kafka settings: %+v", row.Kafka)
+	}
+	if row.ResourcePresetId != "s3-c2-m8" || row.Version != "3.6" {
+		t.Errorf("unexpected shared fields: %+v", row)
+	}
+}
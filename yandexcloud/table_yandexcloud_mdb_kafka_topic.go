@@ -0,0 +1,134 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// MDBKafkaTopicRow is a Kafka topic with its cluster and the settings relevant for retention audits.
+// Optional settings are nil when the topic inherits the cluster default.
+type MDBKafkaTopicRow struct {
+	Name              string
+	ClusterId         string
+	ClusterName       string
+	FolderId          string
+	Partitions        int64
+	ReplicationFactor int64
+	CleanupPolicy     string
+	CompressionType   string
+	RetentionMs       *int64
+	RetentionBytes    *int64
+	MinInsyncReplicas *int64
+	Config            map[string]interface{}
+}
+
+func tableYandexMDBKafkaTopic(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_mdb_kafka_topic",
+		Description: "Yandex Cloud Managed Service for Apache Kafka topics.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "cluster_id", "name"}),
+			Hydrate:    listYandexMDBKafkaTopics,
+		},
+		Columns: []*plugin.Column{
+			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name"), Description: "Topic name."},
+			{Name: "cluster_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ClusterId"), Description: "Cluster ID."},
+			{Name: "cluster_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("ClusterName"), Description: "Cluster name."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId"), Description: "Folder ID containing the cluster."},
+			{Name: "partitions", Type: proto.ColumnType_INT, Transform: transform.FromField("Partitions"), Description: "Number of partitions."},
+			{Name: "replication_factor", Type: proto.ColumnType_INT, Transform: transform.FromField("ReplicationFactor"), Description: "Number of replicas of each partition."},
+			{Name: "cleanup_policy", Type: proto.ColumnType_STRING, Transform: transform.FromField("CleanupPolicy").Transform(transform.NullIfZeroValue), Description: "Cleanup policy, e.g. CLEANUP_POLICY_DELETE or CLEANUP_POLICY_COMPACT. Null if the cluster default applies."},
+			{Name: "compression_type", Type: proto.ColumnType_STRING, Transform: transform.FromField("CompressionType").Transform(transform.NullIfZeroValue), Description: "Compression codec of the topic."},
+			{Name: "retention_ms", Type: proto.ColumnType_INT, Transform: transform.FromField("RetentionMs"), Description: "Retention time in milliseconds; -1 means unlimited. Null if the cluster default applies."},
+			{Name: "retention_bytes", Type: proto.ColumnType_INT, Transform: transform.FromField("RetentionBytes"), Description: "Maximum partition size in bytes before old segments are deleted; -1 means unlimited. Null if the cluster default applies."},
+			{Name: "min_insync_replicas", Type: proto.ColumnType_INT, Transform: transform.FromField("MinInsyncReplicas"), Description: "Minimum number of in-sync replicas for acknowledged writes."},
+			{Name: "config", Type: proto.ColumnType_JSON, Transform: transform.FromField("Config"), Description: "All topic settings."},
+		},
+	}
+}
+
+func listYandexMDBKafkaTopics(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewMDBClient(tok, 30, cfg)
+
+	var folderIDStr *string
+	if cfg.FolderID != nil {
+		str := string(*cfg.FolderID)
+		folderIDStr = &str
+	}
+	folderID := getQualString(d, "folder_id", folderIDStr)
+	if folderID == "" {
+		return nil, fmt.Errorf("folder_id must be provided")
+	}
+	name := getQualString(d, "name", nil)
+
+	clusters, err := listAllMDBClusters(ctx, client, mdbEngineKafka, folderID, getQualString(d, "cluster_id", nil))
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range clusters {
+		pageToken := ""
+		for {
+			topics, nextPageToken, err := client.ListKafkaTopics(ctx, MDBClusterID(c.Id), pageToken, 1000)
+			if err != nil {
+				return nil, err
+			}
+			for _, t := range topics {
+				if name != "" && t.Name != name {
+					continue
+				}
+				d.StreamListItem(ctx, mdbKafkaTopicRow(c, t))
+			}
+			if nextPageToken == "" {
+				break
+			}
+			pageToken = nextPageToken
+		}
+	}
+	return nil, nil
+}
+
+func mdbKafkaTopicRow(c *MDBCluster, t *KafkaTopic) *MDBKafkaTopicRow {
+	row := &MDBKafkaTopicRow{
+		Name:              t.Name,
+		ClusterId:         c.Id,
+		ClusterName:       c.Name,
+		FolderId:          c.FolderId,
+		RetentionMs:       mdbKafkaConfigInt(t.Config, "retentionMs"),
+		RetentionBytes:    mdbKafkaConfigInt(t.Config, "retentionBytes"),
+		MinInsyncReplicas: mdbKafkaConfigInt(t.Config, "minInsyncReplicas"),
+		Config:            t.Config,
+	}
+	row.Partitions, _ = t.Partitions.Int64()
+	row.ReplicationFactor, _ = t.ReplicationFactor.Int64()
+	row.CleanupPolicy, _ = t.Config["cleanupPolicy"].(string)
+	row.CompressionType, _ = t.Config["compressionType"].(string)
+	return row
+}
+
+// mdbKafkaConfigInt reads an Int64Value setting, which the API encodes as a string.
+func mdbKafkaConfigInt(config map[string]interface{}, key string) *int64 {
+	var v int64
+	switch value := config[key].(type) {
+	case string:
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil
+		}
+		v = parsed
+	case float64:
+		v = int64(value)
+	default:
+		return nil
+	}
+	return &v
+}
@@ -0,0 +1,248 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+const (
+	// monitoringDefaultWindow is the time window read when from_time is not set.
+	monitoringDefaultWindow = time.Hour
+	// monitoringDefaultMaxPoints is the number of points per series when no downsampling quals are set.
+	monitoringDefaultMaxPoints = 100
+)
+
+// MonitoringPointRow is a single point of a time series together with the quals it was read with.
+type MonitoringPointRow struct {
+	FolderId                string
+	Query                   string
+	FromTime                time.Time
+	ToTime                  time.Time
+	DownsamplingMaxPoints   int64
+	DownsamplingGridSeconds int64
+	DownsamplingAggregation string
+	Name                    string
+	Labels                  map[string]string
+	Type                    string
+	ResourceId              string
+	Timestamp               time.Time
+	Value                   *float64
+}
+
+func tableYandexMonitoringMetric(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_monitoring_metric",
+		Description: "Yandex Monitoring time series data for a query, one row per point.",
+		List: &plugin.ListConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "query", Require: plugin.Required},
+				{Name: "folder_id", Require: plugin.Optional},
+				{Name: "from_time", Require: plugin.Optional, Operators: []string{">", ">=", "=", "<", "<="}},
+				{Name: "to_time", Require: plugin.Optional, Operators: []string{">", ">=", "=", "<", "<="}},
+				{Name: "downsampling_max_points", Require: plugin.Optional},
+				{Name: "downsampling_grid_interval", Require: plugin.Optional},
+				{Name: "downsampling_aggregation", Require: plugin.Optional},
+			},
+			Hydrate: listYandexMonitoringMetrics,
+		},
+		Columns: []*plugin.Column{
+			{Name: "query", Type: proto.ColumnType_STRING, Transform: transform.FromField("Query"), Description: "Monitoring query language expression, e.g. \"cpu_utilization\"{service=\"compute\"}."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId"), Description: "Folder ID the metrics belong to."},
+			{Name: "from_time", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("FromTime"), Description: "Start of the read window, set with =, >= or >; defaults to one hour before to_time."},
+			{Name: "to_time", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("ToTime"), Description: "End of the read window, set with =, <= or <; defaults to now."},
+			{Name: "downsampling_max_points", Type: proto.ColumnType_INT, Transform: transform.FromField("DownsamplingMaxPoints").Transform(transform.NullIfZeroValue), Description: "Maximum number of points per series; defaults to 100 when no grid interval is set."},
+			{Name: "downsampling_grid_interval", Type: proto.ColumnType_INT, Transform: transform.FromField("DownsamplingGridSeconds").Transform(transform.NullIfZeroValue), Description: "Interval between points in seconds; takes precedence over downsampling_max_points."},
+			{Name: "downsampling_aggregation", Type: proto.ColumnType_STRING, Transform: transform.FromField("DownsamplingAggregation").Transform(transform.NullIfZeroValue), Description: "Aggregation of the points in a grid interval (AVG/MAX/MIN/SUM/LAST/COUNT); defaults to AVG."},
+			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name"), Description: "Metric name."},
+			{Name: "labels", Type: proto.ColumnType_JSON, Transform: transform.FromField("Labels"), Description: "Labels of the time series."},
+			{Name: "type", Type: proto.ColumnType_STRING, Transform: transform.FromField("Type"), Description: "Metric type, e.g. DGAUGE or IGAUGE."},
+			{Name: "resource_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ResourceId").Transform(transform.NullIfZeroValue), Description: "Value of the resource_id label, e.g. the instance ID of a compute metric."},
+			{Name: "timestamp", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Timestamp"), Description: "Time of the point."},
+			{Name: "value", Type: proto.ColumnType_DOUBLE, Transform: transform.FromField("Value"), Description: "Value of the point; null for gaps."},
+		},
+	}
+}
+
+func listYandexMonitoringMetrics(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewMonitoringClient(tok, 30, cfg)
+
+	var folderIDStr *string
+	if cfg.FolderID != nil {
+		str := string(*cfg.FolderID)
+		folderIDStr = &str
+	}
+	folderID := getQualString(d, "folder_id", folderIDStr)
+	if folderID == "" {
+		return nil, fmt.Errorf("folder_id must be provided")
+	}
+
+	from, to, err := monitoringWindow(d.Quals["from_time"], d.Quals["to_time"], time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	var maxPoints, gridSeconds int64
+	if q, ok := d.KeyColumnQuals["downsampling_max_points"]; ok {
+		maxPoints = q.GetInt64Value()
+	}
+	if q, ok := d.KeyColumnQuals["downsampling_grid_interval"]; ok {
+		gridSeconds = q.GetInt64Value()
+	}
+	aggregation := getQualString(d, "downsampling_aggregation", nil)
+
+	query := getQualString(d, "query", nil)
+	series, err := client.ReadMonitoringData(ctx, folderID, &MonitoringReadRequest{
+		Query:        query,
+		FromTime:     from.UTC().Format(time.RFC3339),
+		ToTime:       to.UTC().Format(time.RFC3339),
+		Downsampling: monitoringDownsampling(maxPoints, gridSeconds, aggregation),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range series {
+		for _, row := range monitoringPointRows(s) {
+			row.FolderId = folderID
+			row.Query = query
+			row.FromTime = from
+			row.ToTime = to
+			row.DownsamplingMaxPoints = maxPoints
+			row.DownsamplingGridSeconds = gridSeconds
+			row.DownsamplingAggregation = aggregation
+			d.StreamListItem(ctx, row)
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// monitoringDownsampling builds the downsampling settings from the quals. A grid interval takes
+// precedence over the number of points.
+func monitoringDownsampling(maxPoints int64, gridSeconds int64, aggregation string) *MonitoringDownsampling {
+	if gridSeconds > 0 {
+		if aggregation == "" {
+			aggregation = "AVG"
+		}
+		return &MonitoringDownsampling{GridInterval: gridSeconds * 1000, GridAggregation: aggregation}
+	}
+	if maxPoints <= 0 {
+		maxPoints = monitoringDefaultMaxPoints
+	}
+	return &MonitoringDownsampling{MaxPoints: maxPoints, GridAggregation: aggregation}
+}
+
+// monitoringPointRows flattens a time series into one row per point.
+func monitoringPointRows(series *MonitoringTimeSeries) []*MonitoringPointRow {
+	values := series.Timeseries.DoubleValues
+	if len(values) == 0 {
+		values = series.Timeseries.Int64Values
+	}
+	rows := make([]*MonitoringPointRow, 0, len(series.Timeseries.Timestamps))
+	for i, ts := range series.Timeseries.Timestamps {
+		ms, err := ts.Int64()
+		if err != nil {
+			continue
+		}
+		row := &MonitoringPointRow{
+			Name:       series.Name,
+			Labels:     series.Labels,
+			Type:       series.Type,
+			ResourceId: series.Labels["resource_id"],
+			Timestamp:  time.UnixMilli(ms).UTC(),
+		}
+		if i < len(values) {
+			row.Value = monitoringValue(values[i])
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// monitoringValue converts a JSON point value; NaN and unparsable values are gaps.
+func monitoringValue(v interface{}) *float64 {
+	var f float64
+	switch value := v.(type) {
+	case float64:
+		f = value
+	case string:
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil
+		}
+		f = parsed
+	default:
+		return nil
+	}
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil
+	}
+	return &f
+}
+
+// monitoringWindow returns the read window for the from_time and to_time quals. from_time takes
+// =, >= and > and to_time takes =, <= and <; a strict bound moves the reported window edge by
+// one microsecond, the precision of Postgres timestamps, so that the row still matches the qual.
+// Other operators are rejected rather than silently reading the default window.
+func monitoringWindow(fromQuals, toQuals *plugin.KeyColumnQuals, now time.Time) (from time.Time, to time.Time, err error) {
+	if fromQuals != nil {
+		for _, q := range fromQuals.Quals {
+			ts := q.Value.GetTimestampValue()
+			if ts == nil {
+				continue
+			}
+			t := ts.AsTime()
+			switch q.Operator {
+			case "=", ">=":
+			case ">":
+				t = t.Add(time.Microsecond)
+			default:
+				return from, to, fmt.Errorf("from_time does not support the %s operator, use =, >= or >", q.Operator)
+			}
+			if from.IsZero() || t.After(from) {
+				from = t
+			}
+		}
+	}
+	if toQuals != nil {
+		for _, q := range toQuals.Quals {
+			ts := q.Value.GetTimestampValue()
+			if ts == nil {
+				continue
+			}
+			t := ts.AsTime()
+			switch q.Operator {
+			case "=", "<=":
+			case "<":
+				t = t.Add(-time.Microsecond)
+			default:
+				return from, to, fmt.Errorf("to_time does not support the %s operator, use =, <= or <", q.Operator)
+			}
+			if to.IsZero() || t.Before(to) {
+				to = t
+			}
+		}
+	}
+	if to.IsZero() {
+		to = now
+	}
+	if from.IsZero() {
+		from = to.Add(-monitoringDefaultWindow)
+	}
+	if from.After(to) {
+		return from, to, fmt.Errorf("from_time must not be after to_time")
+	}
+	return from, to, nil
+}
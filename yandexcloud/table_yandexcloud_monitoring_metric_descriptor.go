@@ -0,0 +1,86 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// MonitoringMetricDescriptorRow is a metric name with one of its label sets.
+type MonitoringMetricDescriptorRow struct {
+	FolderId  string
+	Selectors string
+	Metric    *MonitoringMetric
+}
+
+func tableYandexMonitoringMetricDescriptor(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_monitoring_metric_descriptor",
+		Description: "Yandex Monitoring metrics available in a folder, one row per metric name and label set.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "selectors", "service", "name"}),
+			Hydrate:    listYandexMonitoringMetricDescriptors,
+		},
+		Columns: []*plugin.Column{
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId"), Description: "Folder ID the metrics belong to."},
+			{Name: "selectors", Type: proto.ColumnType_STRING, Transform: transform.FromField("Selectors").Transform(transform.NullIfZeroValue), Description: "Label selectors passed to the API, e.g. {service=\"compute\"}."},
+			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Metric.Name"), Description: "Metric name."},
+			{Name: "type", Type: proto.ColumnType_STRING, Transform: transform.FromField("Metric.Type"), Description: "Metric type, e.g. DGAUGE or IGAUGE."},
+			{Name: "labels", Type: proto.ColumnType_JSON, Transform: transform.FromField("Metric.Labels"), Description: "Label set of the metric."},
+			{Name: "service", Type: proto.ColumnType_STRING, Transform: transform.FromField("Metric.Labels.service").Transform(transform.NullIfZeroValue), Description: "Value of the service label, e.g. compute."},
+			{Name: "resource_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Metric.Labels.resource_id").Transform(transform.NullIfZeroValue), Description: "Value of the resource_id label."},
+		},
+	}
+}
+
+func listYandexMonitoringMetricDescriptors(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewMonitoringClient(tok, 30, cfg)
+
+	var folderIDStr *string
+	if cfg.FolderID != nil {
+		str := string(*cfg.FolderID)
+		folderIDStr = &str
+	}
+	folderID := getQualString(d, "folder_id", folderIDStr)
+	if folderID == "" {
+		return nil, fmt.Errorf("folder_id must be provided")
+	}
+
+	selectors := getQualString(d, "selectors", nil)
+	service := getQualString(d, "service", nil)
+	if selectors == "" && service != "" {
+		selectors = fmt.Sprintf("{service=\"%s\"}", service)
+	}
+	name := getQualString(d, "name", nil)
+
+	pageToken := ""
+	pageSize := int64(1000)
+	for {
+		metrics, nextPageToken, err := client.ListMonitoringMetrics(ctx, folderID, selectors, pageToken, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, metric := range metrics {
+			if name != "" && metric.Name != name {
+				continue
+			}
+			if service != "" && metric.Labels["service"] != service {
+				continue
+			}
+			d.StreamListItem(ctx, &MonitoringMetricDescriptorRow{FolderId: folderID, Selectors: getQualString(d, "selectors", nil), Metric: metric})
+		}
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return nil, nil
+}
@@ -0,0 +1,87 @@
+package yandexcloud
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/quals"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMonitoringPointRows(t *testing.T) {
+	body := `{"metrics": [
+		{"name": "cpu_utilization", "type": "DGAUGE", "labels": {"service": "compute", "resource_id": "fhm1"},
+		 "timeseries": {"timestamps": ["1709632800000", 1709632860000, "1709632920000"], "doubleValues": [12.5, "NaN", 3]}},
+		{"name": "disk_count", "type": "IGAUGE", "labels": {"service": "compute"},
+		 "timeseries": {"timestamps": ["1709632800000"], "int64Values": ["7"]}}
+	]}`
+	var resp monitoringReadResponse
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rows := monitoringPointRows(resp.Metrics[0])
+	if len(rows) != 3 {
+		t.Fatalf("expected 3 rows, got %d", len(rows))
+	}
+	if rows[0].ResourceId != "fhm1" || rows[0].Value == nil || *rows[0].Value != 12.5 {
+		t.Errorf("unexpected first row: %+v", rows[0])
+	}
+	if !rows[1].Timestamp.Equal(time.Date(2024, 3, 5, 10, 1, 0, 0, time.UTC)) || rows[1].Value != nil {
+		t.Errorf("expected a gap at 10:01, got %+v", rows[1])
+	}
+	rows = monitoringPointRows(resp.Metrics[1])
+	if len(rows) != 1 || rows[0].Value == nil || *rows[0].Value != 7 || rows[0].ResourceId != "" {
+		t.Errorf("unexpected int64 row: %+v", rows)
+	}
+}
+
+func TestMonitoringDownsampling(t *testing.T) {
+	if ds := monitoringDownsampling(0, 0, ""); ds.MaxPoints != monitoringDefaultMaxPoints || ds.GridInterval != 0 {
+		t.Errorf("unexpected default downsampling: %+v", ds)
+	}
+	if ds := monitoringDownsampling(500, 300, ""); ds.GridInterval != 300000 || ds.GridAggregation != "AVG" || ds.MaxPoints != 0 {
+		t.Errorf("expected the grid interval to take precedence: %+v", ds)
+	}
+	if ds := monitoringDownsampling(50, 0, "MAX"); ds.MaxPoints != 50 || ds.GridAggregation != "MAX" {
+		t.Errorf("unexpected downsampling: %+v", ds)
+	}
+}
+
+func TestMonitoringWindow(t *testing.T) {
+	now := time.Date(2024, 3, 5, 12, 0, 0, 0, time.UTC)
+	timeQuals := func(column string, ops ...interface{}) *plugin.KeyColumnQuals {
+		k := &plugin.KeyColumnQuals{Name: column}
+		for i := 0; i < len(ops); i += 2 {
+			value := &proto.QualValue{Value: &proto.QualValue_TimestampValue{TimestampValue: timestamppb.New(ops[i+1].(time.Time))}}
+			k.Quals = append(k.Quals, &quals.Qual{Column: column, Operator: ops[i].(string), Value: value})
+		}
+		return k
+	}
+	dayAgo := now.Add(-24 * time.Hour)
+
+	from, to, err := monitoringWindow(nil, nil, now)
+	if err != nil || !to.Equal(now) || !from.Equal(now.Add(-time.Hour)) {
+		t.Errorf("unexpected default window: %s - %s, %v", from, to, err)
+	}
+	// from_time > now() - interval '1 day' reads the whole day, not the default hour.
+	from, to, err = monitoringWindow(timeQuals("from_time", ">", dayAgo), nil, now)
+	if err != nil || !from.Equal(dayAgo.Add(time.Microsecond)) || !to.Equal(now) {
+		t.Errorf("unexpected window for >: %s - %s, %v", from, to, err)
+	}
+	from, to, err = monitoringWindow(timeQuals("from_time", ">=", dayAgo), timeQuals("to_time", "<=", now.Add(-time.Hour), "<", now), now)
+	if err != nil || !from.Equal(dayAgo) || !to.Equal(now.Add(-time.Hour)) {
+		t.Errorf("unexpected window for >= and <=: %s - %s, %v", from, to, err)
+	}
+	if _, _, err = monitoringWindow(timeQuals("from_time", "<", now), nil, now); err == nil {
+		t.Errorf("expected an error for from_time <")
+	}
+	if _, _, err = monitoringWindow(nil, timeQuals("to_time", ">=", dayAgo), now); err == nil {
+		t.Errorf("expected an error for to_time >=")
+	}
+	if _, _, err = monitoringWindow(timeQuals("from_time", "=", now), timeQuals("to_time", "=", dayAgo), now); err == nil {
+		t.Errorf("expected an error for from_time after to_time")
+	}
+}
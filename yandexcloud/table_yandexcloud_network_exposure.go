@@ -0,0 +1,582 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// NetworkExposure is a port range of an instance interface or a load balancer listener that is
+// reachable from the internet.
+type NetworkExposure struct {
+	InstanceId          string
+	InstanceName        string
+	FolderId            string
+	InterfaceIndex      string
+	SubnetId            string
+	Ip                  string
+	IpVersion           string
+	AddressId           string
+	ExposureType        string
+	LoadBalancerId      string
+	ListenerName        string
+	TargetAddress       string
+	TargetPort          int64
+	Protocol            string
+	FromPort            int64
+	ToPort              int64
+	SourceCidr          string
+	SecurityGroupStatus string
+	SecurityGroupId     string
+	RuleId              string
+	RuleDescription     string
+}
+
+const (
+	ExposureTypeOneToOneNat = "ONE_TO_ONE_NAT"
+	ExposureTypeNLBListener = "NLB_LISTENER"
+	ExposureTypeALBListener = "ALB_LISTENER"
+)
+
+// Security group statuses of an exposure. APPLIED rows name the ingress rule that allows the
+// traffic. NONE rows have no security group at all, so all traffic is allowed. UNKNOWN rows have
+// security groups that could not be read, so all traffic is reported as potentially allowed.
+const (
+	SecurityGroupStatusApplied = "APPLIED"
+	SecurityGroupStatusNone    = "NONE"
+	SecurityGroupStatusUnknown = "UNKNOWN"
+)
+
+const NetworkLoadBalancerTypeExternal = "EXTERNAL"
+
+// nonInternetCidrs lists ranges that are not routable from the internet.
+var nonInternetCidrs = mustParseCIDRs(
+	"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "100.64.0.0/10",
+	"127.0.0.0/8", "169.254.0.0/16", "198.18.0.0/15",
+	"fc00::/7", "fe80::/10", "::1/128",
+)
+
+// NetworkExposureInputs are the resources exposures are computed from. Networks, subnets and
+// security groups referenced from other folders are added by the caller when they can be read.
+type NetworkExposureInputs struct {
+	Instances            []*Instance
+	Subnets              []*VPCSubnet
+	Networks             []*VPCNetwork
+	Groups               []*VPCSecurityGroup
+	Addresses            []*VPCAddress
+	NetworkLoadBalancers []*NetworkLoadBalancer
+	LBTargetGroups       []*LBTargetGroup
+	ALBLoadBalancers     []*ALBLoadBalancer
+}
+
+// networkExposureGrant is an ingress rule, or the lack of a readable security group, that lets
+// internet traffic through. Rule is only set for SecurityGroupStatusApplied.
+type networkExposureGrant struct {
+	Status          string
+	SecurityGroupId string
+	Rule            *VPCSecurityGroupRuleRow
+}
+
+func tableYandexNetworkExposure(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_network_exposure",
+		Description: "Ports of Yandex Cloud Compute instances and load balancers that are reachable from the internet, derived from public addresses, load balancer listeners and security group ingress rules.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "instance_id"}),
+			Hydrate:    listYandexNetworkExposures,
+		},
+		Columns: []*plugin.Column{
+			{Name: "instance_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("InstanceId").Transform(transform.NullIfZeroValue), Description: "Instance ID; null for application load balancer listeners and unresolved load balancer targets."},
+			{Name: "instance_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("InstanceName").Transform(transform.NullIfZeroValue), Description: "Instance name."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId"), Description: "Folder ID containing the instance or load balancer."},
+			{Name: "interface_index", Type: proto.ColumnType_INT, Transform: transform.FromField("InterfaceIndex").Transform(transform.NullIfZeroValue), Description: "Index of the exposed network interface."},
+			{Name: "subnet_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("SubnetId").Transform(transform.NullIfZeroValue), Description: "Subnet ID of the exposed network interface."},
+			{Name: "ip", Type: proto.ColumnType_INET, Transform: transform.FromField("Ip"), Description: "Public IP address that is reachable from the internet."},
+			{Name: "ip_version", Type: proto.ColumnType_STRING, Transform: transform.FromField("IpVersion"), Description: "IP version of the public address (IPV4/IPV6)."},
+			{Name: "address_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("AddressId").Transform(transform.NullIfZeroValue), Description: "ID of the VPC address holding the public IP, when it is a reserved address of the folder."},
+			{Name: "exposure_type", Type: proto.ColumnType_STRING, Transform: transform.FromField("ExposureType"), Description: "How the address is exposed: ONE_TO_ONE_NAT, NLB_LISTENER or ALB_LISTENER."},
+			{Name: "load_balancer_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("LoadBalancerId").Transform(transform.NullIfZeroValue), Description: "ID of the network or application load balancer exposing the port."},
+			{Name: "listener_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("ListenerName").Transform(transform.NullIfZeroValue), Description: "Name of the load balancer listener exposing the port."},
+			{Name: "target_address", Type: proto.ColumnType_INET, Transform: transform.FromField("TargetAddress").Transform(transform.NullIfZeroValue), Description: "Address of the network load balancer target the traffic is forwarded to."},
+			{Name: "target_port", Type: proto.ColumnType_INT, Transform: transform.FromField("TargetPort").Transform(transform.NullIfZeroValue), Description: "Port of the network load balancer target the traffic is forwarded to."},
+			{Name: "protocol", Type: proto.ColumnType_STRING, Transform: transform.FromField("Protocol"), Description: "Allowed protocol (e.g. TCP, UDP, ANY)."},
+			{Name: "from_port", Type: proto.ColumnType_INT, Transform: transform.FromField("FromPort"), Description: "First port of the reachable range."},
+			{Name: "to_port", Type: proto.ColumnType_INT, Transform: transform.FromField("ToPort"), Description: "Last port of the reachable range."},
+			{Name: "source_cidr", Type: proto.ColumnType_CIDR, Transform: transform.FromField("SourceCidr"), Description: "Source CIDR block allowed by the rule."},
+			{Name: "security_group_status", Type: proto.ColumnType_STRING, Transform: transform.FromField("SecurityGroupStatus"), Description: "APPLIED if a security group rule allows the traffic, NONE if no security group applies and all traffic is allowed, UNKNOWN if an applied security group could not be read."},
+			{Name: "security_group_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("SecurityGroupId").Transform(transform.NullIfZeroValue), Description: "Security group containing the allowing rule, or the security group that could not be read."},
+			{Name: "rule_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("RuleId").Transform(transform.NullIfZeroValue), Description: "ID of the rule that allows the traffic."},
+			{Name: "rule_description", Type: proto.ColumnType_STRING, Transform: transform.FromField("RuleDescription").Transform(transform.NullIfZeroValue), Description: "Description of the rule that allows the traffic."},
+		},
+	}
+}
+
+func listYandexNetworkExposures(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		LogError(ctx, "Failed to get token: %v", err)
+		return nil, err
+	}
+	computeClient := NewComputeClient(tok, 30, cfg)
+	vpcClient := NewVPCClient(tok, 30, cfg)
+	lbClient := NewLoadBalancerClient(tok, 30, cfg)
+	albClient := NewALBClient(tok, 30, cfg)
+
+	var folderIDStr *string
+	if cfg.FolderID != nil {
+		str := string(*cfg.FolderID)
+		folderIDStr = &str
+	}
+	folderID := getQualString(d, "folder_id", folderIDStr)
+	timeoutSec := TimeoutSec(30)
+	if cfg.Timeout != nil && *cfg.Timeout > 0 {
+		timeoutSec = TimeoutSec(*cfg.Timeout)
+	}
+	retryCount := RetryCount(3)
+	if cfg.Retry != nil && *cfg.Retry > 0 {
+		retryCount = RetryCount(*cfg.Retry)
+	}
+
+	var in NetworkExposureInputs
+	instanceID := getQualString(d, "instance_id", nil)
+	if instanceID != "" {
+		inst, err := computeClient.GetInstance(ctx, InstanceID(instanceID), timeoutSec, retryCount)
+		if err != nil {
+			return nil, err
+		}
+		if inst == nil {
+			return nil, nil
+		}
+		in.Instances = append(in.Instances, inst)
+		// Subnets, security groups and load balancers are read from the folder of the instance.
+		folderID = inst.FolderId
+	}
+	if folderID == "" {
+		return nil, fmt.Errorf("folder_id must be provided")
+	}
+	if instanceID == "" {
+		pageToken := PageToken("")
+		for {
+			page, nextPageToken, err := computeClient.ListInstances(ctx, FolderID(folderID), "", pageToken, PageSize(1000), timeoutSec, retryCount)
+			if err != nil {
+				return nil, err
+			}
+			in.Instances = append(in.Instances, page...)
+			if nextPageToken == "" {
+				break
+			}
+			pageToken = nextPageToken
+		}
+	}
+
+	if in.Subnets, err = collectPages(func(pageToken string) ([]*VPCSubnet, string, error) {
+		return vpcClient.ListVPCSubnets(ctx, folderID, pageToken, 1000)
+	}); err != nil {
+		return nil, err
+	}
+	if in.Networks, err = collectPages(func(pageToken string) ([]*VPCNetwork, string, error) {
+		return vpcClient.ListVPCNetworks(ctx, folderID, pageToken, 1000)
+	}); err != nil {
+		return nil, err
+	}
+	if in.Groups, err = collectPages(func(pageToken string) ([]*VPCSecurityGroup, string, error) {
+		return vpcClient.ListVPCSecurityGroups(ctx, folderID, pageToken, 1000)
+	}); err != nil {
+		return nil, err
+	}
+	if in.Addresses, err = collectPages(func(pageToken string) ([]*VPCAddress, string, error) {
+		return vpcClient.ListVPCAddresses(ctx, folderID, pageToken, 1000)
+	}); err != nil {
+		return nil, err
+	}
+	if in.NetworkLoadBalancers, err = collectPages(func(pageToken string) ([]*NetworkLoadBalancer, string, error) {
+		return lbClient.ListNetworkLoadBalancers(ctx, folderID, pageToken, 1000)
+	}); err != nil {
+		return nil, err
+	}
+	if in.LBTargetGroups, err = collectPages(func(pageToken string) ([]*LBTargetGroup, string, error) {
+		return lbClient.ListLBTargetGroups(ctx, folderID, pageToken, 1000)
+	}); err != nil {
+		return nil, err
+	}
+	if instanceID == "" {
+		if in.ALBLoadBalancers, err = collectPages(func(pageToken string) ([]*ALBLoadBalancer, string, error) {
+			return albClient.ListALBLoadBalancers(ctx, folderID, pageToken, 1000)
+		}); err != nil {
+			return nil, err
+		}
+	}
+	resolveNetworkExposureReferences(ctx, vpcClient, &in)
+
+	for _, exposure := range computeNetworkExposures(&in) {
+		if instanceID != "" && exposure.InstanceId != instanceID {
+			continue
+		}
+		d.StreamListItem(ctx, exposure)
+	}
+	return nil, nil
+}
+
+// resolveNetworkExposureReferences reads the subnets, networks and security groups that are
+// referenced by the inputs but live in other folders. Resources that cannot be read are left out
+// and reported with SecurityGroupStatusUnknown.
+func resolveNetworkExposureReferences(ctx context.Context, client VPCClient, in *NetworkExposureInputs) {
+	subnets := make(map[string]bool, len(in.Subnets))
+	for _, s := range in.Subnets {
+		subnets[s.Id] = true
+	}
+	nics := flattenInstanceNetworkInterfacesAll(in.Instances)
+	for _, nic := range nics {
+		if nic.SubnetId == "" || subnets[nic.SubnetId] {
+			continue
+		}
+		subnets[nic.SubnetId] = true
+		subnet, err := client.GetVPCSubnet(ctx, VPCSubnetID(nic.SubnetId))
+		if err != nil || subnet == nil {
+			LogError(ctx, "Failed to read subnet %s: %v", nic.SubnetId, err)
+			continue
+		}
+		in.Subnets = append(in.Subnets, subnet)
+	}
+
+	networks := make(map[string]bool, len(in.Networks))
+	for _, n := range in.Networks {
+		networks[n.Id] = true
+	}
+	var networkIDs []string
+	for _, s := range in.Subnets {
+		networkIDs = append(networkIDs, s.NetworkId)
+	}
+	for _, alb := range in.ALBLoadBalancers {
+		networkIDs = append(networkIDs, alb.NetworkId)
+	}
+	for _, id := range networkIDs {
+		if id == "" || networks[id] {
+			continue
+		}
+		networks[id] = true
+		network, err := client.GetVPCNetwork(ctx, VPCNetworkID(id))
+		if err != nil || network == nil {
+			LogError(ctx, "Failed to read network %s: %v", id, err)
+			continue
+		}
+		in.Networks = append(in.Networks, network)
+	}
+
+	groups := make(map[string]bool, len(in.Groups))
+	for _, g := range in.Groups {
+		groups[g.Id] = true
+	}
+	var groupIDs []string
+	for _, nic := range nics {
+		groupIDs = append(groupIDs, nic.SecurityGroupIds...)
+	}
+	for _, alb := range in.ALBLoadBalancers {
+		groupIDs = append(groupIDs, alb.SecurityGroupIds...)
+	}
+	for _, n := range in.Networks {
+		groupIDs = append(groupIDs, n.DefaultSecurityGroupId)
+	}
+	for _, id := range groupIDs {
+		if id == "" || groups[id] {
+			continue
+		}
+		groups[id] = true
+		group, err := client.GetVPCSecurityGroup(ctx, VPCSecurityGroupID(id))
+		if err != nil || group == nil {
+			LogError(ctx, "Failed to read security group %s: %v", id, err)
+			continue
+		}
+		in.Groups = append(in.Groups, group)
+	}
+}
+
+// networkExposureResolver evaluates the security groups of interfaces and load balancers.
+type networkExposureResolver struct {
+	subnetNetwork  map[string]string
+	networks       map[string]*VPCNetwork
+	groupByID      map[string]*VPCSecurityGroup
+	defaultGroupID map[string]string
+}
+
+func newNetworkExposureResolver(in *NetworkExposureInputs) *networkExposureResolver {
+	r := &networkExposureResolver{
+		subnetNetwork:  make(map[string]string, len(in.Subnets)),
+		networks:       make(map[string]*VPCNetwork, len(in.Networks)),
+		groupByID:      make(map[string]*VPCSecurityGroup, len(in.Groups)),
+		defaultGroupID: make(map[string]string),
+	}
+	for _, s := range in.Subnets {
+		r.subnetNetwork[s.Id] = s.NetworkId
+	}
+	for _, n := range in.Networks {
+		r.networks[n.Id] = n
+		if n.DefaultSecurityGroupId != "" {
+			r.defaultGroupID[n.Id] = n.DefaultSecurityGroupId
+		}
+	}
+	for _, g := range in.Groups {
+		r.groupByID[g.Id] = g
+		if g.DefaultForNetwork {
+			r.defaultGroupID[g.NetworkId] = g.Id
+		}
+	}
+	return r
+}
+
+// grants returns what lets internet traffic of the IP version through the security groups.
+// Without security groups the default security group of the network applies; a network without a
+// default security group allows all traffic.
+func (r *networkExposureResolver) grants(groupIDs []string, networkID string, ipVersion string) []networkExposureGrant {
+	if len(groupIDs) == 0 {
+		if id, ok := r.defaultGroupID[networkID]; ok {
+			groupIDs = []string{id}
+		} else if _, known := r.networks[networkID]; known {
+			return []networkExposureGrant{{Status: SecurityGroupStatusNone}}
+		} else {
+			return []networkExposureGrant{{Status: SecurityGroupStatusUnknown}}
+		}
+	}
+	var grants []networkExposureGrant
+	for _, id := range groupIDs {
+		g, ok := r.groupByID[id]
+		if !ok {
+			grants = append(grants, networkExposureGrant{Status: SecurityGroupStatusUnknown, SecurityGroupId: id})
+			continue
+		}
+		for _, rule := range vpcSecurityGroupRuleRows(g) {
+			if rule.Direction != SecurityGroupRuleDirectionIngress || rule.Cidr == "" {
+				continue
+			}
+			if rule.IpVersion != ipVersion || !isInternetCidr(rule.Cidr) {
+				continue
+			}
+			grants = append(grants, networkExposureGrant{Status: SecurityGroupStatusApplied, SecurityGroupId: g.Id, Rule: rule})
+		}
+	}
+	return grants
+}
+
+// allows reports whether the grant lets traffic of the protocol through to the port.
+func (g networkExposureGrant) allows(protocol string, port int64) bool {
+	if g.Rule == nil {
+		return true
+	}
+	if g.Rule.ProtocolName != "ANY" && g.Rule.ProtocolName != protocol {
+		return false
+	}
+	return g.Rule.FromPort <= port && port <= g.Rule.ToPort
+}
+
+// apply copies the protocol, ports, source and security group of the grant to the exposure.
+// Grants without a rule expose all ports to any source.
+func (g networkExposureGrant) apply(e *NetworkExposure) {
+	e.SecurityGroupStatus = g.Status
+	e.SecurityGroupId = g.SecurityGroupId
+	if g.Rule == nil {
+		e.SourceCidr = "0.0.0.0/0"
+		if e.IpVersion == "IPV6" {
+			e.SourceCidr = "::/0"
+		}
+		if e.Protocol == "" {
+			e.Protocol, e.FromPort, e.ToPort = "ANY", 0, 65535
+		}
+		return
+	}
+	e.SourceCidr = g.Rule.Cidr
+	e.RuleId = g.Rule.RuleId
+	e.RuleDescription = g.Rule.Description
+	if e.Protocol == "" {
+		e.Protocol, e.FromPort, e.ToPort = g.Rule.ProtocolName, g.Rule.FromPort, g.Rule.ToPort
+	}
+}
+
+// computeNetworkExposures reports what is reachable from the internet:
+//   - one-to-one NAT addresses of instance interfaces, one row per ingress rule that allows internet traffic;
+//   - listeners of external network load balancers, one row per target instance whose security groups allow
+//     the target port;
+//   - public listeners of application load balancers whose security groups allow the listener port.
+func computeNetworkExposures(in *NetworkExposureInputs) []*NetworkExposure {
+	r := newNetworkExposureResolver(in)
+	addressID := make(map[string]string, len(in.Addresses))
+	for _, a := range in.Addresses {
+		if a.ExternalIpv4Address != nil && a.ExternalIpv4Address.Address != "" {
+			addressID[a.ExternalIpv4Address.Address] = a.Id
+		}
+	}
+	nics := flattenInstanceNetworkInterfacesAll(in.Instances)
+
+	var exposures []*NetworkExposure
+	add := func(e *NetworkExposure, g networkExposureGrant) {
+		g.apply(e)
+		e.AddressId = addressID[e.Ip]
+		exposures = append(exposures, e)
+	}
+
+	for _, nic := range nics {
+		if nic.NatAddress == "" {
+			continue
+		}
+		for _, g := range r.grants(nic.SecurityGroupIds, r.subnetNetwork[nic.SubnetId], nic.NatIpVersion) {
+			add(&NetworkExposure{
+				InstanceId:     nic.InstanceId,
+				InstanceName:   nic.InstanceName,
+				FolderId:       nic.FolderId,
+				InterfaceIndex: nic.Index,
+				SubnetId:       nic.SubnetId,
+				Ip:             nic.NatAddress,
+				IpVersion:      nic.NatIpVersion,
+				ExposureType:   ExposureTypeOneToOneNat,
+			}, g)
+		}
+	}
+
+	type targetKey struct{ subnetID, address string }
+	nicByTarget := make(map[targetKey]*InstanceNetworkInterface, len(nics))
+	for _, nic := range nics {
+		for _, addr := range []string{nic.PrimaryV4Address, nic.PrimaryV6Address} {
+			if addr != "" {
+				nicByTarget[targetKey{nic.SubnetId, addr}] = nic
+			}
+		}
+	}
+	targetGroups := make(map[string]*LBTargetGroup, len(in.LBTargetGroups))
+	for _, tg := range in.LBTargetGroups {
+		targetGroups[tg.Id] = tg
+	}
+	for _, nlb := range in.NetworkLoadBalancers {
+		if nlb.Type != NetworkLoadBalancerTypeExternal {
+			continue
+		}
+		for _, l := range nlb.Listeners {
+			ipVersion := l.IpVersion
+			if ipVersion == "" {
+				ipVersion = "IPV4"
+			}
+			targetPort := l.TargetPort
+			if targetPort == 0 {
+				targetPort = l.Port
+			}
+			for _, attached := range nlb.AttachedTargetGroups {
+				tg, ok := targetGroups[attached.TargetGroupId]
+				if !ok {
+					continue
+				}
+				for _, target := range tg.Targets {
+					e := NetworkExposure{
+						FolderId:       nlb.FolderId,
+						Ip:             l.Address,
+						IpVersion:      ipVersion,
+						ExposureType:   ExposureTypeNLBListener,
+						LoadBalancerId: nlb.Id,
+						ListenerName:   l.Name,
+						TargetAddress:  target.Address,
+						TargetPort:     targetPort,
+						Protocol:       l.Protocol,
+						FromPort:       l.Port,
+						ToPort:         l.Port,
+						SubnetId:       target.SubnetId,
+					}
+					nic, ok := nicByTarget[targetKey{target.SubnetId, target.Address}]
+					if !ok {
+						// The target is not an instance of the folder, so its security groups are unknown.
+						row := e
+						add(&row, networkExposureGrant{Status: SecurityGroupStatusUnknown})
+						continue
+					}
+					e.InstanceId, e.InstanceName, e.InterfaceIndex = nic.InstanceId, nic.InstanceName, nic.Index
+					for _, g := range r.grants(nic.SecurityGroupIds, r.subnetNetwork[nic.SubnetId], ipVersion) {
+						if !g.allows(l.Protocol, targetPort) {
+							continue
+						}
+						row := e
+						add(&row, g)
+					}
+				}
+			}
+		}
+	}
+
+	for _, alb := range in.ALBLoadBalancers {
+		for _, l := range alb.Listeners {
+			for _, endpoint := range l.Endpoints {
+				for _, address := range endpoint.Addresses {
+					ip, ipVersion := "", "IPV4"
+					switch {
+					case address.ExternalIpv4Address != nil:
+						ip = address.ExternalIpv4Address.Address
+					case address.ExternalIpv6Address != nil:
+						ip, ipVersion = address.ExternalIpv6Address.Address, "IPV6"
+					}
+					if ip == "" {
+						continue
+					}
+					for _, p := range endpoint.Ports {
+						port, err := p.Int64()
+						if err != nil {
+							continue
+						}
+						for _, g := range r.grants(alb.SecurityGroupIds, alb.NetworkId, ipVersion) {
+							if !g.allows("TCP", port) {
+								continue
+							}
+							add(&NetworkExposure{
+								FolderId:       alb.FolderId,
+								Ip:             ip,
+								IpVersion:      ipVersion,
+								ExposureType:   ExposureTypeALBListener,
+								LoadBalancerId: alb.Id,
+								ListenerName:   l.Name,
+								Protocol:       "TCP",
+								FromPort:       port,
+								ToPort:         port,
+							}, g)
+						}
+					}
+				}
+			}
+		}
+	}
+	return exposures
+}
+
+func flattenInstanceNetworkInterfacesAll(instances []*Instance) []*InstanceNetworkInterface {
+	var rows []*InstanceNetworkInterface
+	for _, inst := range instances {
+		rows = append(rows, flattenInstanceNetworkInterfaces(inst)...)
+	}
+	return rows
+}
+
+// isInternetCidr reports whether the CIDR block contains addresses routable from the internet.
+func isInternetCidr(cidr string) bool {
+	_, block, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return false
+	}
+	blockOnes, _ := block.Mask.Size()
+	for _, private := range nonInternetCidrs {
+		ones, _ := private.Mask.Size()
+		if ones <= blockOnes && private.Contains(block.IP) {
+			return false
+		}
+	}
+	return true
+}
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	blocks := make([]*net.IPNet, 0, len(cidrs))
+	for _, c := range cidrs {
+		_, block, err := net.ParseCIDR(c)
+		if err != nil {
+			panic(err)
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks
+}
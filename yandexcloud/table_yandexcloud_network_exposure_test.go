@@ -0,0 +1,198 @@
+package yandexcloud
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestIsInternetCidr(t *testing.T) {
+	cases := map[string]bool{
+		"0.0.0.0/0":      true,
+		"51.250.0.0/16":  true,
+		"10.0.0.0/8":     false,
+		"10.1.2.0/24":    false,
+		"192.168.1.1/32": false,
+		"::/0":           true,
+		"fd00::/8":       false,
+		"not-a-cidr":     false,
+	}
+	for cidr, want := range cases {
+		if got := isInternetCidr(cidr); got != want {
+			t.Errorf("isInternetCidr(%q) = %v, want %v", cidr, got, want)
+		}
+	}
+}
+
+func TestComputeNetworkExposures(t *testing.T) {
+	instances := []*Instance{
+		{
+			Id: "inst1",
+			NetworkInterfaces: []NetworkInterface{{
+				Index:            "0",
+				SubnetId:         "subnet1",
+				PrimaryV4Address: &PrimaryAddress{Address: "10.0.0.5", OneToOneNat: &OneToOneNat{Address: "51.250.1.2", IpVersion: "IPV4"}},
+			}},
+		},
+		{
+			Id: "inst2",
+			NetworkInterfaces: []NetworkInterface{{
+				Index:            "0",
+				SubnetId:         "subnet1",
+				PrimaryV4Address: &PrimaryAddress{Address: "10.0.0.6"},
+			}},
+		},
+	}
+	subnets := []*VPCSubnet{{Id: "subnet1", NetworkId: "net1"}}
+	groups := []*VPCSecurityGroup{{
+		Id:                "sg-default",
+		NetworkId:         "net1",
+		DefaultForNetwork: true,
+		Rules: []SecurityGroupRule{
+			{Id: "ssh", Direction: "INGRESS", ProtocolName: "TCP", Ports: &PortRange{FromPort: 22, ToPort: 22}, CidrBlocks: &CidrBlocks{V4CidrBlocks: []string{"0.0.0.0/0"}}},
+			{Id: "internal", Direction: "INGRESS", ProtocolName: "ANY", CidrBlocks: &CidrBlocks{V4CidrBlocks: []string{"10.0.0.0/8"}}},
+			{Id: "out", Direction: "EGRESS", ProtocolName: "ANY", CidrBlocks: &CidrBlocks{V4CidrBlocks: []string{"0.0.0.0/0"}}},
+		},
+	}}
+
+	networks := []*VPCNetwork{{Id: "net1"}}
+
+	exposures := computeNetworkExposures(&NetworkExposureInputs{Instances: instances, Subnets: subnets, Networks: networks, Groups: groups})
+	if len(exposures) != 1 {
+		t.Fatalf("expected 1 exposure, got %d: %+v", len(exposures), exposures)
+	}
+	e := exposures[0]
+	if e.InstanceId != "inst1" || e.Ip != "51.250.1.2" || e.RuleId != "ssh" || e.FromPort != 22 || e.ToPort != 22 {
+		t.Errorf("unexpected exposure: %+v", e)
+	}
+	if e.SecurityGroupId != "sg-default" || e.ExposureType != ExposureTypeOneToOneNat || e.SecurityGroupStatus != SecurityGroupStatusApplied {
+		t.Errorf("unexpected exposure source: %+v", e)
+	}
+}
+
+func TestComputeNetworkExposuresWithoutSecurityGroups(t *testing.T) {
+	instances := []*Instance{{
+		Id: "inst1",
+		NetworkInterfaces: []NetworkInterface{{
+			Index:            "0",
+			SubnetId:         "subnet1",
+			PrimaryV4Address: &PrimaryAddress{Address: "10.0.0.5", OneToOneNat: &OneToOneNat{Address: "51.250.1.2", IpVersion: "IPV4"}},
+		}},
+	}, {
+		Id: "inst2",
+		NetworkInterfaces: []NetworkInterface{{
+			Index:            "0",
+			SubnetId:         "subnet1",
+			PrimaryV4Address: &PrimaryAddress{Address: "10.0.0.6", OneToOneNat: &OneToOneNat{Address: "51.250.1.3", IpVersion: "IPV4"}},
+			SecurityGroupIds: []string{"sg-other-folder"},
+		}},
+	}}
+	subnets := []*VPCSubnet{{Id: "subnet1", NetworkId: "net1"}}
+	networks := []*VPCNetwork{{Id: "net1"}}
+	addresses := []*VPCAddress{{Id: "addr1", ExternalIpv4Address: &ExternalIpv4Address{Address: "51.250.1.2"}}}
+
+	exposures := computeNetworkExposures(&NetworkExposureInputs{Instances: instances, Subnets: subnets, Networks: networks, Addresses: addresses})
+	if len(exposures) != 2 {
+		t.Fatalf("expected 2 exposures, got %d: %+v", len(exposures), exposures)
+	}
+	open := exposures[0]
+	if open.InstanceId != "inst1" || open.SecurityGroupStatus != SecurityGroupStatusNone || open.Protocol != "ANY" || open.FromPort != 0 || open.ToPort != 65535 || open.SourceCidr != "0.0.0.0/0" || open.AddressId != "addr1" {
+		t.Errorf("unexpected unrestricted exposure: %+v", open)
+	}
+	unknown := exposures[1]
+	if unknown.InstanceId != "inst2" || unknown.SecurityGroupStatus != SecurityGroupStatusUnknown || unknown.SecurityGroupId != "sg-other-folder" {
+		t.Errorf("unexpected unknown exposure: %+v", unknown)
+	}
+
+	// The default security group of an unread network cannot be evaluated.
+	exposures = computeNetworkExposures(&NetworkExposureInputs{Instances: instances[:1], Subnets: subnets})
+	if len(exposures) != 1 || exposures[0].SecurityGroupStatus != SecurityGroupStatusUnknown {
+		t.Errorf("unexpected exposures for unread network: %+v", exposures)
+	}
+}
+
+func TestComputeNetworkExposuresLoadBalancers(t *testing.T) {
+	instances := []*Instance{{
+		Id: "inst1",
+		NetworkInterfaces: []NetworkInterface{{
+			Index:            "0",
+			SubnetId:         "subnet1",
+			PrimaryV4Address: &PrimaryAddress{Address: "10.0.0.5"},
+			SecurityGroupIds: []string{"sg-web"},
+		}},
+	}}
+	subnets := []*VPCSubnet{{Id: "subnet1", NetworkId: "net1"}}
+	networks := []*VPCNetwork{{Id: "net1"}}
+	groups := []*VPCSecurityGroup{{
+		Id:        "sg-web",
+		NetworkId: "net1",
+		Rules: []SecurityGroupRule{
+			{Id: "http", Direction: "INGRESS", ProtocolName: "TCP", Ports: &PortRange{FromPort: 8080, ToPort: 8080}, CidrBlocks: &CidrBlocks{V4CidrBlocks: []string{"0.0.0.0/0"}}},
+		},
+	}, {
+		Id:        "sg-alb",
+		NetworkId: "net1",
+		Rules: []SecurityGroupRule{
+			{Id: "https", Direction: "INGRESS", ProtocolName: "TCP", Ports: &PortRange{FromPort: 443, ToPort: 443}, CidrBlocks: &CidrBlocks{V4CidrBlocks: []string{"0.0.0.0/0"}}},
+		},
+	}}
+	nlbs := []*NetworkLoadBalancer{{
+		Id:   "nlb1",
+		Type: NetworkLoadBalancerTypeExternal,
+		Listeners: []NLBListener{
+			{Name: "web", Address: "84.201.1.1", Port: 80, Protocol: "TCP", TargetPort: 8080},
+			{Name: "admin", Address: "84.201.1.1", Port: 9000, Protocol: "TCP", TargetPort: 9000},
+		},
+		AttachedTargetGroups: []AttachedTargetGroup{{TargetGroupId: "tg1"}},
+	}, {
+		Id:                   "nlb-internal",
+		Type:                 "INTERNAL",
+		Listeners:            []NLBListener{{Name: "web", Address: "10.0.0.100", Port: 80, Protocol: "TCP"}},
+		AttachedTargetGroups: []AttachedTargetGroup{{TargetGroupId: "tg1"}},
+	}}
+	targetGroups := []*LBTargetGroup{{Id: "tg1", Targets: []LBTarget{{SubnetId: "subnet1", Address: "10.0.0.5"}, {SubnetId: "subnet9", Address: "10.9.0.5"}}}}
+	albs := []*ALBLoadBalancer{{
+		Id:               "alb1",
+		NetworkId:        "net1",
+		SecurityGroupIds: []string{"sg-alb"},
+		Listeners: []ALBListener{{
+			Name: "https",
+			Endpoints: []ALBEndpoint{{
+				Addresses: []ALBAddress{{ExternalIpv4Address: &ALBAddressValue{Address: "158.160.1.1"}}, {InternalIpv4Address: &ALBAddressValue{Address: "10.0.0.200"}}},
+				Ports:     []json.Number{"443", "8443"},
+			}},
+		}},
+	}}
+
+	exposures := computeNetworkExposures(&NetworkExposureInputs{
+		Instances: instances, Subnets: subnets, Networks: networks, Groups: groups,
+		NetworkLoadBalancers: nlbs, LBTargetGroups: targetGroups, ALBLoadBalancers: albs,
+	})
+	var nlbRows, unresolved, albRows []*NetworkExposure
+	for _, e := range exposures {
+		switch {
+		case e.ExposureType == ExposureTypeNLBListener && e.InstanceId != "":
+			nlbRows = append(nlbRows, e)
+		case e.ExposureType == ExposureTypeNLBListener:
+			unresolved = append(unresolved, e)
+		case e.ExposureType == ExposureTypeALBListener:
+			albRows = append(albRows, e)
+		default:
+			t.Errorf("unexpected exposure: %+v", e)
+		}
+	}
+	if len(nlbRows) != 1 {
+		t.Fatalf("expected 1 NLB exposure, got %+v", nlbRows)
+	}
+	if e := nlbRows[0]; e.InstanceId != "inst1" || e.ListenerName != "web" || e.FromPort != 80 || e.TargetPort != 8080 || e.Ip != "84.201.1.1" || e.RuleId != "http" || e.LoadBalancerId != "nlb1" {
+		t.Errorf("unexpected NLB exposure: %+v", e)
+	}
+	if len(unresolved) != 2 || unresolved[0].TargetAddress != "10.9.0.5" || unresolved[0].SecurityGroupStatus != SecurityGroupStatusUnknown {
+		t.Errorf("unexpected unresolved NLB targets: %+v", unresolved)
+	}
+	if len(albRows) != 1 {
+		t.Fatalf("expected 1 ALB exposure, got %+v", albRows)
+	}
+	if e := albRows[0]; e.LoadBalancerId != "alb1" || e.Ip != "158.160.1.1" || e.FromPort != 443 || e.RuleId != "https" || e.InstanceId != "" {
+		t.Errorf("unexpected ALB exposure: %+v", e)
+	}
+}
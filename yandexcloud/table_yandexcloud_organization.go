@@ -0,0 +1,129 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+func tableYandexOrganization(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_organization",
+		Description: "Yandex Cloud Organization Manager organizations.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"organization_id", "name"}),
+			Hydrate:    listYandexOrganizations,
+		},
+		Columns: []*plugin.Column{
+			{Name: "organization_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "Organization ID."},
+			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name"), Description: "Organization name."},
+			{Name: "title", Type: proto.ColumnType_STRING, Transform: transform.FromField("Title").Transform(transform.NullIfZeroValue), Description: "Display title of the organization."},
+			{Name: "description", Type: proto.ColumnType_STRING, Transform: transform.FromField("Description"), Description: "Organization description."},
+			{Name: "created_at", Type: proto.ColumnType_STRING, Transform: transform.From(createdAtOrganizationDateTransform), Description: "Organization creation date (YYYY-MM-DD)."},
+			{Name: "labels", Type: proto.ColumnType_JSON, Transform: transform.FromField("Labels"), Description: "Resource labels as key:value pairs."},
+		},
+	}
+}
+
+func listYandexOrganizations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewOrganizationClient(tok, 30, cfg)
+
+	var filters []string
+	if id := getQualString(d, "organization_id", nil); id != "" {
+		filters = append(filters, fmt.Sprintf("(id = \"%s\")", id))
+	}
+	if n := getQualString(d, "name", nil); n != "" {
+		filters = append(filters, fmt.Sprintf("(name = \"%s\")", n))
+	}
+
+	organizations, err := listAllOrganizations(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	for _, org := range organizations {
+		if len(filters) > 0 {
+			if !organizationMatchesFilters(org, filters) {
+				continue
+			}
+		}
+		d.StreamListItem(ctx, org)
+	}
+	return nil, nil
+}
+
+func listAllOrganizations(ctx context.Context, client OrganizationClient) ([]*Organization, error) {
+	var all []*Organization
+	pageToken := ""
+	pageSize := int64(1000)
+	for {
+		organizations, nextPageToken, err := client.ListOrganizations(ctx, pageToken, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, organizations...)
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return all, nil
+}
+
+// organizationIDs returns the organization from the organization_id qual or the connection config,
+// or every organization visible to the caller when neither is set.
+func organizationIDs(ctx context.Context, d *plugin.QueryData, cfg *Config, client OrganizationClient) ([]string, error) {
+	var orgIDStr *string
+	if cfg.OrganizationID != nil {
+		str := string(*cfg.OrganizationID)
+		orgIDStr = &str
+	}
+	if orgID := getQualString(d, "organization_id", orgIDStr); orgID != "" {
+		return []string{orgID}, nil
+	}
+	organizations, err := listAllOrganizations(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(organizations))
+	for _, org := range organizations {
+		ids = append(ids, org.Id)
+	}
+	return ids, nil
+}
+
+// Manual filtering, since the API does not support filters
+func organizationMatchesFilters(org *Organization, filters []string) bool {
+	for _, f := range filters {
+		if strings.HasPrefix(f, "(id = ") && !strings.Contains(f, org.Id) {
+			return false
+		}
+		if strings.HasPrefix(f, "(name = ") && !strings.Contains(f, org.Name) {
+			return false
+		}
+	}
+	return true
+}
+
+// Transform function for created_at: returns only the date (YYYY-MM-DD)
+func createdAtOrganizationDateTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if d.HydrateItem == nil {
+		return nil, nil
+	}
+	org, ok := d.HydrateItem.(*Organization)
+	if !ok || org.CreatedAt == "" {
+		return nil, nil
+	}
+	if len(org.CreatedAt) < 10 {
+		return org.CreatedAt, nil
+	}
+	return org.CreatedAt[:10], nil
+}
@@ -0,0 +1,76 @@
+package yandexcloud
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// OrganizationAccessBindingRow is a role granted on an organization.
+type OrganizationAccessBindingRow struct {
+	OrganizationId string
+	RoleId         string
+	SubjectId      string
+	SubjectType    string
+}
+
+func tableYandexOrganizationAccessBinding(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_organization_access_binding",
+		Description: "Access bindings granted on Yandex Cloud organizations.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"organization_id", "role_id", "subject_id"}),
+			Hydrate:    listYandexOrganizationAccessBindings,
+		},
+		Columns: []*plugin.Column{
+			{Name: "organization_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("OrganizationId"), Description: "Organization ID the role is granted on."},
+			{Name: "role_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("RoleId"), Description: "Granted role, e.g. organization-manager.admin."},
+			{Name: "subject_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("SubjectId"), Description: "ID of the subject the role is granted to."},
+			{Name: "subject_type", Type: proto.ColumnType_STRING, Transform: transform.FromField("SubjectType"), Description: "Type of the subject, e.g. userAccount, federatedUser, serviceAccount, group or system."},
+		},
+	}
+}
+
+func listYandexOrganizationAccessBindings(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewOrganizationClient(tok, 30, cfg)
+	orgIDs, err := organizationIDs(ctx, d, cfg, client)
+	if err != nil {
+		return nil, err
+	}
+
+	roleID := getQualString(d, "role_id", nil)
+	subjectID := getQualString(d, "subject_id", nil)
+	for _, orgID := range orgIDs {
+		pageToken := ""
+		pageSize := int64(1000)
+		for {
+			bindings, nextPageToken, err := client.ListOrganizationAccessBindings(ctx, orgID, pageToken, pageSize)
+			if err != nil {
+				return nil, err
+			}
+			for _, b := range bindings {
+				if (roleID != "" && b.RoleId != roleID) || (subjectID != "" && b.Subject.Id != subjectID) {
+					continue
+				}
+				d.StreamListItem(ctx, &OrganizationAccessBindingRow{
+					OrganizationId: orgID,
+					RoleId:         b.RoleId,
+					SubjectId:      b.Subject.Id,
+					SubjectType:    b.Subject.Type,
+				})
+			}
+			if nextPageToken == "" {
+				break
+			}
+			pageToken = nextPageToken
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,101 @@
+package yandexcloud
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// OrganizationGroupMemberRow is a member of a group.
+type OrganizationGroupMemberRow struct {
+	OrganizationId string
+	GroupId        string
+	GroupName      string
+	SubjectId      string
+	SubjectType    string
+}
+
+func tableYandexOrganizationGroupMember(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_organization_group_member",
+		Description: "Members of Yandex Cloud Organization Manager user groups.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"organization_id", "group_id", "subject_id"}),
+			Hydrate:    listYandexOrganizationGroupMembers,
+		},
+		Columns: []*plugin.Column{
+			{Name: "group_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("GroupId"), Description: "Group ID."},
+			{Name: "group_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("GroupName"), Description: "Group name."},
+			{Name: "organization_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("OrganizationId"), Description: "Organization ID containing the group."},
+			{Name: "subject_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("SubjectId"), Description: "ID of the member: a user or service account."},
+			{Name: "subject_type", Type: proto.ColumnType_STRING, Transform: transform.FromField("SubjectType"), Description: "Type of the member, e.g. userAccount, federatedUser or serviceAccount."},
+		},
+	}
+}
+
+func listYandexOrganizationGroupMembers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewOrganizationClient(tok, 30, cfg)
+	orgIDs, err := organizationIDs(ctx, d, cfg, client)
+	if err != nil {
+		return nil, err
+	}
+
+	groupID := getQualString(d, "group_id", nil)
+	subjectID := getQualString(d, "subject_id", nil)
+	for _, orgID := range orgIDs {
+		rows, err := listAllOrganizationGroupMembers(ctx, client, orgID, groupID)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			if subjectID != "" && row.SubjectId != subjectID {
+				continue
+			}
+			d.StreamListItem(ctx, row)
+		}
+	}
+	return nil, nil
+}
+
+// listAllOrganizationGroupMembers returns the members of all groups of an organization, or of a
+// single group when groupID is set.
+func listAllOrganizationGroupMembers(ctx context.Context, client OrganizationClient, orgID string, groupID string) ([]*OrganizationGroupMemberRow, error) {
+	groups, err := listAllOrganizationGroups(ctx, client, orgID)
+	if err != nil {
+		return nil, err
+	}
+	var rows []*OrganizationGroupMemberRow
+	for _, group := range groups {
+		if groupID != "" && group.Id != groupID {
+			continue
+		}
+		pageToken := ""
+		for {
+			members, nextPageToken, err := client.ListOrganizationGroupMembers(ctx, group.Id, pageToken, 1000)
+			if err != nil {
+				return nil, err
+			}
+			for _, m := range members {
+				rows = append(rows, &OrganizationGroupMemberRow{
+					OrganizationId: orgID,
+					GroupId:        group.Id,
+					GroupName:      group.Name,
+					SubjectId:      m.SubjectId,
+					SubjectType:    m.SubjectType,
+				})
+			}
+			if nextPageToken == "" {
+				break
+			}
+			pageToken = nextPageToken
+		}
+	}
+	return rows, nil
+}
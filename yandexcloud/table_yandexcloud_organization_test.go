@@ -0,0 +1,75 @@
+package yandexcloud
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/context_key"
+)
+
+func TestOrganizationUserRow(t *testing.T) {
+	body := `{"users": [
+		{"subjectClaims": {"sub": "aje1", "name": "Jane Doe", "preferred_username": "jane@example.com", "email": "jane@example.com", "federation": {"id": "bpf1", "name": "corp-adfs"}}},
+		{"subjectClaims": {"sub": "aje2", "preferred_username": "john.doe"}}
+	]}`
+	var resp ListOrganizationUsersResponse
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	row := organizationUserRow("bpforg", resp.Users[0])
+	if row.Type != OrganizationUserTypeFederated || row.FederationId != "bpf1" || row.FederationName != "corp-adfs" || row.Id != "aje1" {
+		t.Errorf("unexpected federated user: %+v", row)
+	}
+	row = organizationUserRow("bpforg", resp.Users[1])
+	if row.Type != OrganizationUserTypePassport || row.FederationId != "" || row.PreferredUsername != "john.doe" || row.OrganizationId != "bpforg" {
+		t.Errorf("unexpected passport user: %+v", row)
+	}
+}
+
+func TestSAMLFederationCertificates(t *testing.T) {
+	newCert := func(notAfter time.Time) string {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		tmpl := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: "idp.example.com"},
+			NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+			NotAfter:     notAfter,
+		}
+		der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	}
+	soon := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	later := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	ctx := context.WithValue(context.Background(), context_key.Logger, hclog.NewNullLogger())
+	result := samlFederationCertificates(ctx, []*SAMLCertificate{
+		{Id: "c1", Name: "current", Data: newCert(later)},
+		{Id: "c2", Name: "old", Data: newCert(soon)},
+		{Id: "c3", Name: "broken", Data: "not a certificate"},
+	})
+	if len(result.Certificates) != 3 {
+		t.Fatalf("expected 3 certificates, got %d", len(result.Certificates))
+	}
+	if result.ExpiresAt == nil || !result.ExpiresAt.Equal(soon) {
+		t.Errorf("expected earliest expiry %v, got %v", soon, result.ExpiresAt)
+	}
+	if result.Certificates[0].Subject != "CN=idp.example.com" || result.Certificates[2].NotAfter != nil {
+		t.Errorf("unexpected certificate info: %+v %+v", result.Certificates[0], result.Certificates[2])
+	}
+}
@@ -0,0 +1,116 @@
+package yandexcloud
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+const (
+	OrganizationUserTypeFederated = "FEDERATED"
+	OrganizationUserTypePassport  = "PASSPORT"
+)
+
+// OrganizationUserRow is a flattened organization member.
+type OrganizationUserRow struct {
+	OrganizationId    string
+	Id                string
+	Type              string
+	Name              string
+	GivenName         string
+	FamilyName        string
+	PreferredUsername string
+	Email             string
+	PhoneNumber       string
+	FederationId      string
+	FederationName    string
+}
+
+func tableYandexOrganizationUser(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_organization_user",
+		Description: "Users of Yandex Cloud organizations: federated users and Yandex ID (Passport) accounts.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"organization_id", "user_id", "type", "federation_id"}),
+			Hydrate:    listYandexOrganizationUsers,
+		},
+		Columns: []*plugin.Column{
+			{Name: "user_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "User ID (subject ID used in access bindings)."},
+			{Name: "organization_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("OrganizationId"), Description: "Organization ID the user belongs to."},
+			{Name: "type", Type: proto.ColumnType_STRING, Transform: transform.FromField("Type"), Description: "User type (FEDERATED/PASSPORT)."},
+			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name").Transform(transform.NullIfZeroValue), Description: "Full name of the user."},
+			{Name: "given_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("GivenName").Transform(transform.NullIfZeroValue), Description: "Given name of the user."},
+			{Name: "family_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("FamilyName").Transform(transform.NullIfZeroValue), Description: "Family name of the user."},
+			{Name: "preferred_username", Type: proto.ColumnType_STRING, Transform: transform.FromField("PreferredUsername").Transform(transform.NullIfZeroValue), Description: "Login of the user, e.g. the Yandex ID login or the federated name ID."},
+			{Name: "email", Type: proto.ColumnType_STRING, Transform: transform.FromField("Email").Transform(transform.NullIfZeroValue), Description: "Email address of the user."},
+			{Name: "phone_number", Type: proto.ColumnType_STRING, Transform: transform.FromField("PhoneNumber").Transform(transform.NullIfZeroValue), Description: "Phone number of the user."},
+			{Name: "federation_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FederationId").Transform(transform.NullIfZeroValue), Description: "Federation ID of a federated user."},
+			{Name: "federation_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("FederationName").Transform(transform.NullIfZeroValue), Description: "Federation name of a federated user."},
+		},
+	}
+}
+
+func listYandexOrganizationUsers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewOrganizationClient(tok, 30, cfg)
+	orgIDs, err := organizationIDs(ctx, d, cfg, client)
+	if err != nil {
+		return nil, err
+	}
+
+	userID := getQualString(d, "user_id", nil)
+	userType := getQualString(d, "type", nil)
+	federationID := getQualString(d, "federation_id", nil)
+
+	for _, orgID := range orgIDs {
+		pageToken := ""
+		pageSize := int64(1000)
+		for {
+			users, nextPageToken, err := client.ListOrganizationUsers(ctx, orgID, pageToken, pageSize)
+			if err != nil {
+				return nil, err
+			}
+			for _, user := range users {
+				row := organizationUserRow(orgID, user)
+				if (userID != "" && row.Id != userID) || (userType != "" && row.Type != userType) || (federationID != "" && row.FederationId != federationID) {
+					continue
+				}
+				d.StreamListItem(ctx, row)
+			}
+			if nextPageToken == "" {
+				break
+			}
+			pageToken = nextPageToken
+		}
+	}
+	return nil, nil
+}
+
+// organizationUserRow flattens the subject claims; users with a federation are federated users,
+// the others are Yandex ID (Passport) accounts.
+func organizationUserRow(orgID string, user *OrganizationUser) *OrganizationUserRow {
+	claims := user.SubjectClaims
+	row := &OrganizationUserRow{
+		OrganizationId:    orgID,
+		Id:                claims.Sub,
+		Type:              OrganizationUserTypePassport,
+		Name:              claims.Name,
+		GivenName:         claims.GivenName,
+		FamilyName:        claims.FamilyName,
+		PreferredUsername: claims.PreferredUsername,
+		Email:             claims.Email,
+		PhoneNumber:       claims.PhoneNumber,
+	}
+	if claims.Federation != nil && claims.Federation.Id != "" {
+		row.Type = OrganizationUserTypeFederated
+		row.FederationId = claims.Federation.Id
+		row.FederationName = claims.Federation.Name
+	}
+	return row
+}
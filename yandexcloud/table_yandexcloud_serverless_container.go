@@ -0,0 +1,226 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+const ServerlessContainerRevisionStatusActive = "ACTIVE"
+
+// ServerlessContainerRow is a container together with its active revision.
+// Only the names of environment variables are kept; their values are never exposed.
+type ServerlessContainerRow struct {
+	Id                      string
+	FolderId                string
+	CreatedAt               string
+	Name                    string
+	Description             string
+	Labels                  map[string]string
+	Url                     string
+	Status                  string
+	RevisionId              string
+	RevisionCreatedAt       string
+	ImageUrl                string
+	ImageDigest             string
+	Concurrency             int64
+	Memory                  int64
+	Cores                   int64
+	CoreFraction            int64
+	ExecutionTimeoutSeconds int64
+	ServiceAccountId        string
+	Secrets                 []ServerlessSecret
+	EnvironmentKeys         []string
+	ProvisionedInstances    int64
+	ScalingPolicy           map[string]interface{}
+	NetworkId               string
+	SubnetIds               []string
+	LogOptions              map[string]interface{}
+}
+
+func tableYandexServerlessContainer(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_serverless_container",
+		Description: "Yandex Cloud Serverless Containers with their active revision.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "container_id", "name", "status"}),
+			Hydrate:    listYandexServerlessContainers,
+		},
+		Columns: []*plugin.Column{
+			{Name: "container_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "Container ID."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId"), Description: "Folder ID containing the container."},
+			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name"), Description: "Container name."},
+			{Name: "description", Type: proto.ColumnType_STRING, Transform: transform.FromField("Description"), Description: "Container description."},
+			{Name: "created_at", Type: proto.ColumnType_STRING, Transform: transform.From(createdAtServerlessContainerDateTransform), Description: "Container creation date (YYYY-MM-DD)."},
+			{Name: "labels", Type: proto.ColumnType_JSON, Transform: transform.FromField("Labels"), Description: "Resource labels as key:value pairs."},
+			{Name: "url", Type: proto.ColumnType_STRING, Transform: transform.FromField("Url"), Description: "URL to invoke the container."},
+			{Name: "status", Type: proto.ColumnType_STRING, Transform: transform.FromField("Status"), Description: "Container status (CREATING/ACTIVE/DELETING/ERROR)."},
+			{Name: "revision_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("RevisionId").Transform(transform.NullIfZeroValue), Description: "ID of the active revision."},
+			{Name: "revision_created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("RevisionCreatedAt").Transform(transform.NullIfZeroValue), Description: "Time the active revision was deployed."},
+			{Name: "image_url", Type: proto.ColumnType_STRING, Transform: transform.FromField("ImageUrl").Transform(transform.NullIfZeroValue), Description: "Image URL of the active revision."},
+			{Name: "image_digest", Type: proto.ColumnType_STRING, Transform: transform.FromField("ImageDigest").Transform(transform.NullIfZeroValue), Description: "Image digest of the active revision."},
+			{Name: "concurrency", Type: proto.ColumnType_INT, Transform: transform.FromField("Concurrency"), Description: "Maximum number of concurrent requests per instance."},
+			{Name: "memory", Type: proto.ColumnType_INT, Transform: transform.FromField("Memory"), Description: "Memory available to an instance, in bytes."},
+			{Name: "cores", Type: proto.ColumnType_INT, Transform: transform.FromField("Cores"), Description: "Number of cores available to an instance."},
+			{Name: "core_fraction", Type: proto.ColumnType_INT, Transform: transform.FromField("CoreFraction"), Description: "Guaranteed CPU share of an instance, in percent."},
+			{Name: "execution_timeout_seconds", Type: proto.ColumnType_INT, Transform: transform.FromField("ExecutionTimeoutSeconds"), Description: "Request timeout, in seconds."},
+			{Name: "service_account_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ServiceAccountId").Transform(transform.NullIfZeroValue), Description: "ID of the service account the revision runs as."},
+			{Name: "secrets", Type: proto.ColumnType_JSON, Transform: transform.FromField("Secrets"), Description: "Lockbox secret references exposed to the revision."},
+			{Name: "environment_keys", Type: proto.ColumnType_JSON, Transform: transform.FromField("EnvironmentKeys"), Description: "Names of the environment variables. Values are never exposed."},
+			{Name: "provisioned_instances", Type: proto.ColumnType_INT, Transform: transform.FromField("ProvisionedInstances"), Description: "Number of instances kept provisioned."},
+			{Name: "scaling_policy", Type: proto.ColumnType_JSON, Transform: transform.FromField("ScalingPolicy"), Description: "Scaling limits of the revision."},
+			{Name: "network_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("NetworkId").Transform(transform.NullIfZeroValue), Description: "ID of the VPC network the revision is connected to."},
+			{Name: "subnet_ids", Type: proto.ColumnType_JSON, Transform: transform.FromField("SubnetIds"), Description: "IDs of the subnets the revision is connected to."},
+			{Name: "log_options", Type: proto.ColumnType_JSON, Transform: transform.FromField("LogOptions"), Description: "Logging options of the revision."},
+		},
+	}
+}
+
+func listYandexServerlessContainers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewServerlessClient(tok, 30, cfg)
+
+	var folderIDStr *string
+	if cfg.FolderID != nil {
+		str := string(*cfg.FolderID)
+		folderIDStr = &str
+	}
+	folderID := getQualString(d, "folder_id", folderIDStr)
+	if folderID == "" {
+		return nil, fmt.Errorf("folder_id must be provided")
+	}
+	containerID := getQualString(d, "container_id", nil)
+	name := getQualString(d, "name", nil)
+	status := getQualString(d, "status", nil)
+
+	revisionsByContainer := map[string][]*ServerlessContainerRevision{}
+	pageToken := ""
+	pageSize := int64(1000)
+	for {
+		revisions, nextPageToken, err := client.ListServerlessContainerRevisions(ctx, folderID, pageToken, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range revisions {
+			revisionsByContainer[r.ContainerId] = append(revisionsByContainer[r.ContainerId], r)
+		}
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+
+	pageToken = ""
+	for {
+		containers, nextPageToken, err := client.ListServerlessContainers(ctx, folderID, pageToken, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range containers {
+			if containerID != "" && c.Id != containerID {
+				continue
+			}
+			if name != "" && c.Name != name {
+				continue
+			}
+			if status != "" && c.Status != status {
+				continue
+			}
+			d.StreamListItem(ctx, serverlessContainerRow(c, revisionsByContainer[c.Id]))
+		}
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return nil, nil
+}
+
+// serverlessContainerRow flattens a container with the most recently created of its active revisions.
+func serverlessContainerRow(c *ServerlessContainer, revisions []*ServerlessContainerRevision) *ServerlessContainerRow {
+	row := &ServerlessContainerRow{
+		Id:              c.Id,
+		FolderId:        c.FolderId,
+		CreatedAt:       c.CreatedAt,
+		Name:            c.Name,
+		Description:     c.Description,
+		Labels:          c.Labels,
+		Url:             c.Url,
+		Status:          c.Status,
+		EnvironmentKeys: []string{},
+		SubnetIds:       []string{},
+	}
+	var active *ServerlessContainerRevision
+	var activeCreatedAt time.Time
+	for _, r := range revisions {
+		if r.Status != ServerlessContainerRevisionStatusActive {
+			continue
+		}
+		// Timestamps differ in their fractional seconds, so they are compared as times, not strings.
+		createdAt, _ := time.Parse(time.RFC3339Nano, r.CreatedAt)
+		if active == nil || createdAt.After(activeCreatedAt) {
+			active = r
+			activeCreatedAt = createdAt
+		}
+	}
+	if active == nil {
+		return row
+	}
+	row.RevisionId = active.Id
+	row.RevisionCreatedAt = active.CreatedAt
+	row.Concurrency = active.Concurrency
+	row.ServiceAccountId = active.ServiceAccountId
+	row.Secrets = active.Secrets
+	row.ScalingPolicy = active.ScalingPolicy
+	row.LogOptions = active.LogOptions
+	if active.Image != nil {
+		row.ImageUrl = active.Image.ImageUrl
+		row.ImageDigest = active.Image.ImageDigest
+		for k := range active.Image.Environment {
+			row.EnvironmentKeys = append(row.EnvironmentKeys, k)
+		}
+		sort.Strings(row.EnvironmentKeys)
+	}
+	if active.Resources != nil {
+		row.Memory = active.Resources.Memory
+		row.Cores = active.Resources.Cores
+		row.CoreFraction = active.Resources.CoreFraction
+	}
+	if timeout, err := time.ParseDuration(active.ExecutionTimeout); err == nil {
+		row.ExecutionTimeoutSeconds = int64(timeout.Seconds())
+	}
+	if active.ProvisionPolicy != nil {
+		row.ProvisionedInstances = active.ProvisionPolicy.MinInstances
+	}
+	if active.Connectivity != nil {
+		row.NetworkId = active.Connectivity.NetworkId
+		if active.Connectivity.SubnetId != nil {
+			row.SubnetIds = active.Connectivity.SubnetId
+		}
+	}
+	return row
+}
+
+// Transform function for created_at: returns only the date (YYYY-MM-DD)
+func createdAtServerlessContainerDateTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if d.HydrateItem == nil {
+		return nil, nil
+	}
+	c, ok := d.HydrateItem.(*ServerlessContainerRow)
+	if !ok || c.CreatedAt == "" {
+		return nil, nil
+	}
+	if len(c.CreatedAt) < 10 {
+		return c.CreatedAt, nil
+	}
+	return c.CreatedAt[:10], nil
+}
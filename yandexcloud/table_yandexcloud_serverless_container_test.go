@@ -0,0 +1,49 @@
+package yandexcloud
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestServerlessContainerRow(t *testing.T) {
+	raw := `[
+		{"id": "r1", "containerId": "c1", "createdAt": "2024-01-01T00:00:00Z", "status": "OBSOLETE", "image": {"imageUrl": "cr.yandex/old"}},
+		{"id": "r2", "containerId": "c1", "createdAt": "2024-02-01T00:00:00Z", "status": "ACTIVE",
+			"image": {"imageUrl": "cr.yandex/app:v2", "imageDigest": "sha256:abc", "environment": {"TOKEN": "x", "MODE": "prod"}},
+			"resources": {"memory": "536870912", "cores": "1", "coreFraction": "100"},
+			"executionTimeout": "30s", "concurrency": "4", "serviceAccountId": "sa1",
+			"secrets": [{"id": "s1", "versionId": "sv1", "key": "pwd", "environmentVariable": "DB_PASSWORD"}],
+			"provisionPolicy": {"minInstances": "2"}}
+	]`
+	var revisions []*ServerlessContainerRevision
+	if err := json.Unmarshal([]byte(raw), &revisions); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	row := serverlessContainerRow(&ServerlessContainer{Id: "c1", Name: "app"}, revisions)
+	if row.RevisionId != "r2" || row.ImageUrl != "cr.yandex/app:v2" || row.ImageDigest != "sha256:abc" {
+		t.Errorf("unexpected revision: %+v", row)
+	}
+	if row.Memory != 536870912 || row.Cores != 1 || row.Concurrency != 4 || row.ExecutionTimeoutSeconds != 30 || row.ProvisionedInstances != 2 {
+		t.Errorf("unexpected resources: %+v", row)
+	}
+	if len(row.EnvironmentKeys) != 2 || row.EnvironmentKeys[0] != "MODE" || row.EnvironmentKeys[1] != "TOKEN" {
+		t.Errorf("unexpected environment keys: %v", row.EnvironmentKeys)
+	}
+	if len(row.Secrets) != 1 || row.Secrets[0].EnvironmentVariable != "DB_PASSWORD" {
+		t.Errorf("unexpected secrets: %+v", row.Secrets)
+	}
+
+	row = serverlessContainerRow(&ServerlessContainer{Id: "c2"}, revisions[:1])
+	if row.RevisionId != "" || row.ImageUrl != "" {
+		t.Errorf("expected no active revision: %+v", row)
+	}
+
+	// A later revision with fractional seconds sorts before an earlier one without them as a string.
+	revisions = []*ServerlessContainerRevision{
+		{Id: "r3", Status: ServerlessContainerRevisionStatusActive, CreatedAt: "2024-03-01T10:00:00Z"},
+		{Id: "r4", Status: ServerlessContainerRevisionStatusActive, CreatedAt: "2024-03-01T10:00:00.250Z"},
+	}
+	if row = serverlessContainerRow(&ServerlessContainer{Id: "c3"}, revisions); row.RevisionId != "r4" {
+		t.Errorf("expected the latest active revision r4, got %q", row.RevisionId)
+	}
+}
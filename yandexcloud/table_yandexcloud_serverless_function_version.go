@@ -0,0 +1,209 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// ServerlessFunctionVersionLatestTag is the tag the API moves to the newest version of a function.
+const ServerlessFunctionVersionLatestTag = "$latest"
+
+// ServerlessFunctionVersionRow is a function version together with the function it belongs to.
+// Only the names of environment variables are kept; their values are never exposed.
+type ServerlessFunctionVersionRow struct {
+	Id                      string
+	FunctionId              string
+	FunctionName            string
+	FolderId                string
+	Description             string
+	CreatedAt               string
+	Runtime                 string
+	RuntimeDeprecated       bool
+	Entrypoint              string
+	Memory                  int64
+	ExecutionTimeoutSeconds int64
+	ServiceAccountId        string
+	ImageSize               int64
+	Status                  string
+	Tags                    []string
+	IsLatest                bool
+	EnvironmentKeys         []string
+	NetworkId               string
+	SubnetIds               []string
+	Secrets                 []ServerlessSecret
+	LogOptions              map[string]interface{}
+	Concurrency             int64
+}
+
+func tableYandexServerlessFunctionVersion(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_serverless_function_version",
+		Description: "Yandex Cloud Functions versions with runtime, resources and service account.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "function_id", "runtime", "status"}),
+			Hydrate:    listYandexServerlessFunctionVersions,
+		},
+		Columns: []*plugin.Column{
+			{Name: "version_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "Version ID."},
+			{Name: "function_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FunctionId"), Description: "Function ID."},
+			{Name: "function_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("FunctionName"), Description: "Function name."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId"), Description: "Folder ID containing the function."},
+			{Name: "description", Type: proto.ColumnType_STRING, Transform: transform.FromField("Description"), Description: "Version description."},
+			{Name: "created_at", Type: proto.ColumnType_STRING, Transform: transform.From(createdAtServerlessFunctionVersionDateTransform), Description: "Version creation date (YYYY-MM-DD)."},
+			{Name: "runtime", Type: proto.ColumnType_STRING, Transform: transform.FromField("Runtime"), Description: "Runtime of the version, e.g. python312."},
+			{Name: "runtime_deprecated", Type: proto.ColumnType_BOOL, Transform: transform.FromField("RuntimeDeprecated"), Description: "Whether the runtime is no longer offered for new versions."},
+			{Name: "entrypoint", Type: proto.ColumnType_STRING, Transform: transform.FromField("Entrypoint"), Description: "Entrypoint of the function."},
+			{Name: "memory", Type: proto.ColumnType_INT, Transform: transform.FromField("Memory"), Description: "Memory available to the version, in bytes."},
+			{Name: "execution_timeout_seconds", Type: proto.ColumnType_INT, Transform: transform.FromField("ExecutionTimeoutSeconds"), Description: "Execution timeout, in seconds."},
+			{Name: "service_account_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ServiceAccountId").Transform(transform.NullIfZeroValue), Description: "ID of the service account the version runs as."},
+			{Name: "image_size", Type: proto.ColumnType_INT, Transform: transform.FromField("ImageSize"), Description: "Size of the version image, in bytes."},
+			{Name: "status", Type: proto.ColumnType_STRING, Transform: transform.FromField("Status"), Description: "Version status (CREATING/ACTIVE/OBSOLETE/DELETING)."},
+			{Name: "tags", Type: proto.ColumnType_JSON, Transform: transform.FromField("Tags"), Description: "Version tags."},
+			{Name: "is_latest", Type: proto.ColumnType_BOOL, Transform: transform.FromField("IsLatest"), Description: "Whether the version has the $latest tag."},
+			{Name: "environment_keys", Type: proto.ColumnType_JSON, Transform: transform.FromField("EnvironmentKeys"), Description: "Names of the environment variables. Values are never exposed."},
+			{Name: "network_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("NetworkId").Transform(transform.NullIfZeroValue), Description: "ID of the VPC network the version is connected to."},
+			{Name: "subnet_ids", Type: proto.ColumnType_JSON, Transform: transform.FromField("SubnetIds"), Description: "IDs of the subnets the version is connected to."},
+			{Name: "secrets", Type: proto.ColumnType_JSON, Transform: transform.FromField("Secrets"), Description: "Lockbox secret references exposed to the version."},
+			{Name: "log_options", Type: proto.ColumnType_JSON, Transform: transform.FromField("LogOptions"), Description: "Logging options of the version."},
+			{Name: "concurrency", Type: proto.ColumnType_INT, Transform: transform.FromField("Concurrency"), Description: "Maximum number of concurrent calls per instance."},
+		},
+	}
+}
+
+func listYandexServerlessFunctionVersions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewServerlessClient(tok, 30, cfg)
+
+	var folderIDStr *string
+	if cfg.FolderID != nil {
+		str := string(*cfg.FolderID)
+		folderIDStr = &str
+	}
+	folderID := getQualString(d, "folder_id", folderIDStr)
+	if folderID == "" {
+		return nil, fmt.Errorf("folder_id must be provided")
+	}
+	functionID := getQualString(d, "function_id", nil)
+	runtime := getQualString(d, "runtime", nil)
+	status := getQualString(d, "status", nil)
+
+	functions, err := listAllServerlessFunctions(ctx, client, folderID)
+	if err != nil {
+		return nil, err
+	}
+	functionsByID := make(map[string]*ServerlessFunction, len(functions))
+	for _, fn := range functions {
+		functionsByID[fn.Id] = fn
+	}
+	if functionID != "" && functionsByID[functionID] == nil {
+		return nil, nil
+	}
+	runtimes, err := client.ListServerlessRuntimes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pageToken := ""
+	pageSize := int64(1000)
+	for {
+		versions, nextPageToken, err := client.ListServerlessFunctionVersions(ctx, folderID, ServerlessFunctionID(functionID), pageToken, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range versions {
+			if runtime != "" && v.Runtime != runtime {
+				continue
+			}
+			if status != "" && v.Status != status {
+				continue
+			}
+			fn := functionsByID[v.FunctionId]
+			if fn == nil {
+				continue
+			}
+			d.StreamListItem(ctx, serverlessFunctionVersionRow(fn, v, runtimes))
+		}
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return nil, nil
+}
+
+// serverlessFunctionVersionRow flattens a version; runtimes is the list of currently available runtimes.
+func serverlessFunctionVersionRow(fn *ServerlessFunction, v *ServerlessFunctionVersion, runtimes []string) *ServerlessFunctionVersionRow {
+	row := &ServerlessFunctionVersionRow{
+		Id:                v.Id,
+		FunctionId:        fn.Id,
+		FunctionName:      fn.Name,
+		FolderId:          fn.FolderId,
+		Description:       v.Description,
+		CreatedAt:         v.CreatedAt,
+		Runtime:           v.Runtime,
+		RuntimeDeprecated: true,
+		Entrypoint:        v.Entrypoint,
+		ServiceAccountId:  v.ServiceAccountId,
+		ImageSize:         v.ImageSize,
+		Status:            v.Status,
+		Tags:              v.Tags,
+		EnvironmentKeys:   []string{},
+		SubnetIds:         []string{},
+		Secrets:           v.Secrets,
+		LogOptions:        v.LogOptions,
+		Concurrency:       v.Concurrency,
+	}
+	for _, r := range runtimes {
+		if r == v.Runtime {
+			row.RuntimeDeprecated = false
+			break
+		}
+	}
+	for _, tag := range v.Tags {
+		if tag == ServerlessFunctionVersionLatestTag {
+			row.IsLatest = true
+		}
+	}
+	if v.Resources != nil {
+		row.Memory = v.Resources.Memory
+	}
+	if timeout, err := time.ParseDuration(v.ExecutionTimeout); err == nil {
+		row.ExecutionTimeoutSeconds = int64(timeout.Seconds())
+	}
+	for k := range v.Environment {
+		row.EnvironmentKeys = append(row.EnvironmentKeys, k)
+	}
+	sort.Strings(row.EnvironmentKeys)
+	if v.Connectivity != nil {
+		row.NetworkId = v.Connectivity.NetworkId
+		if v.Connectivity.SubnetId != nil {
+			row.SubnetIds = v.Connectivity.SubnetId
+		}
+	}
+	return row
+}
+
+// Transform function for created_at: returns only the date (YYYY-MM-DD)
+func createdAtServerlessFunctionVersionDateTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if d.HydrateItem == nil {
+		return nil, nil
+	}
+	version, ok := d.HydrateItem.(*ServerlessFunctionVersionRow)
+	if !ok || version.CreatedAt == "" {
+		return nil, nil
+	}
+	if len(version.CreatedAt) < 10 {
+		return version.CreatedAt, nil
+	}
+	return version.CreatedAt[:10], nil
+}
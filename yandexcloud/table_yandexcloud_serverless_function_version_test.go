@@ -0,0 +1,49 @@
+package yandexcloud
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+func TestServerlessFunctionVersionRow(t *testing.T) {
+	raw := `{
+		"id": "v1",
+		"functionId": "fn1",
+		"createdAt": "2024-03-05T10:20:30.123Z",
+		"runtime": "nodejs12",
+		"entrypoint": "index.handler",
+		"resources": {"memory": "134217728"},
+		"executionTimeout": "3.500s",
+		"serviceAccountId": "sa1",
+		"tags": ["$latest"],
+		"environment": {"DB_PASSWORD": "secret", "API_URL": "https://example.com"},
+		"connectivity": {"networkId": "net1"}
+	}`
+	var v ServerlessFunctionVersion
+	if err := json.Unmarshal([]byte(raw), &v); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	fn := &ServerlessFunction{Id: "fn1", Name: "api", FolderId: "f1"}
+	row := serverlessFunctionVersionRow(fn, &v, []string{"nodejs18", "python312"})
+	if !row.RuntimeDeprecated || !row.IsLatest {
+		t.Errorf("expected deprecated latest version: %+v", row)
+	}
+	if row.Memory != 134217728 || row.ExecutionTimeoutSeconds != 3 {
+		t.Errorf("unexpected resources: memory %d, timeout %d", row.Memory, row.ExecutionTimeoutSeconds)
+	}
+	if len(row.EnvironmentKeys) != 2 || row.EnvironmentKeys[0] != "API_URL" || row.EnvironmentKeys[1] != "DB_PASSWORD" {
+		t.Errorf("unexpected environment keys: %v", row.EnvironmentKeys)
+	}
+	if row.NetworkId != "net1" || row.FolderId != "f1" || row.FunctionName != "api" {
+		t.Errorf("unexpected function fields: %+v", row)
+	}
+	if got := columnValue(t, tableYandexServerlessFunctionVersion(context.Background()), "created_at", row); got != "2024-03-05" {
+		t.Errorf("unexpected created_at: %v", got)
+	}
+
+	row = serverlessFunctionVersionRow(fn, &ServerlessFunctionVersion{Id: "v2", Runtime: "python312"}, []string{"nodejs18", "python312"})
+	if row.RuntimeDeprecated || row.IsLatest {
+		t.Errorf("expected supported non-latest version: %+v", row)
+	}
+}
@@ -0,0 +1,196 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+const (
+	ServerlessTriggerTargetFunction  = "FUNCTION"
+	ServerlessTriggerTargetContainer = "CONTAINER"
+	ServerlessTriggerTargetGateway   = "GATEWAY"
+)
+
+// ServerlessTriggerRow is a trigger with the invocation target extracted from its rule.
+type ServerlessTriggerRow struct {
+	Id                string
+	FolderId          string
+	CreatedAt         string
+	Name              string
+	Description       string
+	Labels            map[string]string
+	Status            string
+	RuleType          string
+	Rule              map[string]interface{}
+	TargetType        string
+	FunctionId        string
+	FunctionTag       string
+	ContainerId       string
+	GatewayId         string
+	ServiceAccountId  string
+	ServiceAccountIds []string
+}
+
+func tableYandexServerlessTrigger(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_serverless_trigger",
+		Description: "Yandex Cloud Functions triggers with their rule and invocation target.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "trigger_id", "name", "rule_type"}),
+			Hydrate:    listYandexServerlessTriggers,
+		},
+		Columns: []*plugin.Column{
+			{Name: "trigger_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Id"), Description: "Trigger ID."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId"), Description: "Folder ID containing the trigger."},
+			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name"), Description: "Trigger name."},
+			{Name: "description", Type: proto.ColumnType_STRING, Transform: transform.FromField("Description"), Description: "Trigger description."},
+			{Name: "created_at", Type: proto.ColumnType_STRING, Transform: transform.From(createdAtServerlessTriggerDateTransform), Description: "Trigger creation date (YYYY-MM-DD)."},
+			{Name: "labels", Type: proto.ColumnType_JSON, Transform: transform.FromField("Labels"), Description: "Resource labels as key:value pairs."},
+			{Name: "status", Type: proto.ColumnType_STRING, Transform: transform.FromField("Status"), Description: "Trigger status (ACTIVE/PAUSED)."},
+			{Name: "rule_type", Type: proto.ColumnType_STRING, Transform: transform.FromField("RuleType"), Description: "Type of the trigger rule, e.g. timer, messageQueue or objectStorage."},
+			{Name: "rule", Type: proto.ColumnType_JSON, Transform: transform.FromField("Rule"), Description: "Trigger rule as returned by the API."},
+			{Name: "target_type", Type: proto.ColumnType_STRING, Transform: transform.FromField("TargetType").Transform(transform.NullIfZeroValue), Description: "Type of the invocation target (FUNCTION/CONTAINER/GATEWAY)."},
+			{Name: "function_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FunctionId").Transform(transform.NullIfZeroValue), Description: "ID of the invoked function."},
+			{Name: "function_tag", Type: proto.ColumnType_STRING, Transform: transform.FromField("FunctionTag").Transform(transform.NullIfZeroValue), Description: "Version tag of the invoked function."},
+			{Name: "container_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ContainerId").Transform(transform.NullIfZeroValue), Description: "ID of the invoked serverless container."},
+			{Name: "gateway_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("GatewayId").Transform(transform.NullIfZeroValue), Description: "ID of the API gateway receiving websocket broadcasts."},
+			{Name: "service_account_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ServiceAccountId").Transform(transform.NullIfZeroValue), Description: "ID of the service account used to invoke the target."},
+			{Name: "service_account_ids", Type: proto.ColumnType_JSON, Transform: transform.FromField("ServiceAccountIds"), Description: "IDs of all service accounts referenced by the rule, including those used to read the event source."},
+		},
+	}
+}
+
+func listYandexServerlessTriggers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewServerlessClient(tok, 30, cfg)
+
+	var folderIDStr *string
+	if cfg.FolderID != nil {
+		str := string(*cfg.FolderID)
+		folderIDStr = &str
+	}
+	folderID := getQualString(d, "folder_id", folderIDStr)
+	if folderID == "" {
+		return nil, fmt.Errorf("folder_id must be provided")
+	}
+	triggerID := getQualString(d, "trigger_id", nil)
+	name := getQualString(d, "name", nil)
+	ruleType := getQualString(d, "rule_type", nil)
+
+	pageToken := ""
+	pageSize := int64(1000)
+	for {
+		triggers, nextPageToken, err := client.ListServerlessTriggers(ctx, folderID, pageToken, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range triggers {
+			if triggerID != "" && t.Id != triggerID {
+				continue
+			}
+			if name != "" && t.Name != name {
+				continue
+			}
+			row := serverlessTriggerRow(t)
+			if ruleType != "" && row.RuleType != ruleType {
+				continue
+			}
+			d.StreamListItem(ctx, row)
+		}
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return nil, nil
+}
+
+// serverlessTriggerRow extracts the rule type and invocation target of a trigger. The rule is a
+// one-of keyed by its type, and the target is one of the invoke* or gateway fields of that rule.
+func serverlessTriggerRow(t *ServerlessTrigger) *ServerlessTriggerRow {
+	row := &ServerlessTriggerRow{
+		Id:                t.Id,
+		FolderId:          t.FolderId,
+		CreatedAt:         t.CreatedAt,
+		Name:              t.Name,
+		Description:       t.Description,
+		Labels:            t.Labels,
+		Status:            t.Status,
+		Rule:              t.Rule,
+		ServiceAccountIds: []string{},
+	}
+	for ruleType, value := range t.Rule {
+		body, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		row.RuleType = ruleType
+		for key, target := range body {
+			targetMap, ok := target.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			switch key {
+			case "invokeFunction", "invokeFunctionWithRetry":
+				row.TargetType = ServerlessTriggerTargetFunction
+				row.FunctionId, _ = targetMap["functionId"].(string)
+				row.FunctionTag, _ = targetMap["functionTag"].(string)
+			case "invokeContainer", "invokeContainerWithRetry":
+				row.TargetType = ServerlessTriggerTargetContainer
+				row.ContainerId, _ = targetMap["containerId"].(string)
+			case "gatewayWebsocketBroadcast":
+				row.TargetType = ServerlessTriggerTargetGateway
+				row.GatewayId, _ = targetMap["gatewayId"].(string)
+			default:
+				continue
+			}
+			row.ServiceAccountId, _ = targetMap["serviceAccountId"].(string)
+		}
+		collectServiceAccountIDs(body, &row.ServiceAccountIds)
+		break
+	}
+	sort.Strings(row.ServiceAccountIds)
+	return row
+}
+
+// collectServiceAccountIDs appends every distinct serviceAccountId found in a nested JSON value.
+func collectServiceAccountIDs(value interface{}, ids *[]string) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if id, ok := item.(string); ok && key == "serviceAccountId" && id != "" {
+				*ids = appendUniqueString(*ids, id)
+				continue
+			}
+			collectServiceAccountIDs(item, ids)
+		}
+	case []interface{}:
+		for _, item := range v {
+			collectServiceAccountIDs(item, ids)
+		}
+	}
+}
+
+// Transform function for created_at: returns only the date (YYYY-MM-DD)
+func createdAtServerlessTriggerDateTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if d.HydrateItem == nil {
+		return nil, nil
+	}
+	t, ok := d.HydrateItem.(*ServerlessTriggerRow)
+	if !ok || t.CreatedAt == "" {
+		return nil, nil
+	}
+	if len(t.CreatedAt) < 10 {
+		return t.CreatedAt, nil
+	}
+	return t.CreatedAt[:10], nil
+}
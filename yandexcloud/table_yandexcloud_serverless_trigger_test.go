@@ -0,0 +1,54 @@
+package yandexcloud
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestServerlessTriggerRow(t *testing.T) {
+	raw := `{
+		"id": "t1",
+		"folderId": "f1",
+		"rule": {
+			"messageQueue": {
+				"queueId": "yrn:yc:ymq:ru-central1:f1:queue",
+				"serviceAccountId": "sa-read",
+				"batchSettings": {"size": "10"},
+				"invokeFunction": {"functionId": "fn1", "functionTag": "$latest", "serviceAccountId": "sa-invoke"}
+			}
+		}
+	}`
+	var trigger ServerlessTrigger
+	if err := json.Unmarshal([]byte(raw), &trigger); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	row := serverlessTriggerRow(&trigger)
+	if row.RuleType != "messageQueue" || row.TargetType != ServerlessTriggerTargetFunction {
+		t.Errorf("unexpected rule type or target: %+v", row)
+	}
+	if row.FunctionId != "fn1" || row.FunctionTag != "$latest" || row.ServiceAccountId != "sa-invoke" {
+		t.Errorf("unexpected function target: %+v", row)
+	}
+	if len(row.ServiceAccountIds) != 2 || row.ServiceAccountIds[0] != "sa-invoke" || row.ServiceAccountIds[1] != "sa-read" {
+		t.Errorf("unexpected service accounts: %v", row.ServiceAccountIds)
+	}
+}
+
+func TestServerlessTriggerRowContainer(t *testing.T) {
+	trigger := &ServerlessTrigger{
+		Id: "t2",
+		Rule: map[string]interface{}{
+			"timer": map[string]interface{}{
+				"cronExpression":           "0 * * * ? *",
+				"invokeContainerWithRetry": map[string]interface{}{"containerId": "c1", "serviceAccountId": "sa1"},
+			},
+		},
+	}
+	row := serverlessTriggerRow(trigger)
+	if row.RuleType != "timer" || row.TargetType != ServerlessTriggerTargetContainer || row.ContainerId != "c1" || row.ServiceAccountId != "sa1" {
+		t.Errorf("unexpected container target: %+v", row)
+	}
+	if row.FunctionId != "" {
+		t.Errorf("expected no function target, got %q", row.FunctionId)
+	}
+}
@@ -49,8 +49,9 @@ func listYandexVPCRouteTables(ctx context.Context, d *plugin.QueryData, _ *plugi
 		folderIDStr = &str
 	}
 	folderID := getQualString(d, "folder_id", folderIDStr)
-	if folderID == "" {
-		return nil, fmt.Errorf("folder_id must be provided")
+	networkID := getQualString(d, "network_id", nil)
+	if folderID == "" && networkID == "" {
+		return nil, fmt.Errorf("folder_id or network_id must be provided")
 	}
 
 	var filters []string
@@ -70,7 +71,7 @@ func listYandexVPCRouteTables(ctx context.Context, d *plugin.QueryData, _ *plugi
 	pageToken := ""
 	pageSize := int64(1000)
 	for {
-		routeTables, nextPageToken, err := client.ListVPCRouteTables(ctx, folderID, pageToken, pageSize)
+		routeTables, nextPageToken, err := listVPCRouteTablesPage(ctx, client, folderID, networkID, pageToken, pageSize)
 		if err != nil {
 			return nil, err
 		}
@@ -118,6 +119,15 @@ func getYandexVPCRouteTable(ctx context.Context, d *plugin.QueryData, h *plugin.
 	return rt, nil
 }
 
+// listVPCRouteTablesPage returns a page of route tables of the network when networkID is set,
+// using the network-scoped list method, and of the folder otherwise.
+func listVPCRouteTablesPage(ctx context.Context, client VPCClient, folderID, networkID string, pageToken string, pageSize int64) ([]*VPCRouteTable, string, error) {
+	if networkID != "" {
+		return client.ListVPCNetworkRouteTables(ctx, VPCNetworkID(networkID), pageToken, pageSize)
+	}
+	return client.ListVPCRouteTables(ctx, folderID, pageToken, pageSize)
+}
+
 // Manual filtering, since the API does not support filters except folderId
 func vpcRouteTableMatchesFilters(rt *VPCRouteTable, filters []string) bool {
 	for _, f := range filters {
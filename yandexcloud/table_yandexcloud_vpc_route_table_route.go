@@ -0,0 +1,129 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// VPCRouteTableRoute is a static route together with the route table it belongs to.
+type VPCRouteTableRoute struct {
+	RouteTableId      string
+	RouteTableName    string
+	NetworkId         string
+	FolderId          string
+	DestinationPrefix string
+	NextHopAddress    string
+	GatewayId         string
+	NextHopType       string
+	Labels            map[string]string
+}
+
+const (
+	RouteNextHopTypeAddress = "ADDRESS"
+	RouteNextHopTypeGateway = "GATEWAY"
+)
+
+func tableYandexVPCRouteTableRoute(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_vpc_route_table_route",
+		Description: "Yandex Cloud VPC route table static routes, one row per route.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "route_table_id", "network_id", "gateway_id"}),
+			Hydrate:    listYandexVPCRouteTableRoutes,
+		},
+		Columns: []*plugin.Column{
+			{Name: "route_table_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("RouteTableId"), Description: "Route table ID."},
+			{Name: "route_table_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("RouteTableName"), Description: "Route table name."},
+			{Name: "network_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("NetworkId"), Description: "Network ID of the route table."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId"), Description: "Folder ID containing the route table."},
+			{Name: "destination_prefix", Type: proto.ColumnType_CIDR, Transform: transform.FromField("DestinationPrefix"), Description: "Destination subnet in CIDR notation."},
+			{Name: "next_hop_type", Type: proto.ColumnType_STRING, Transform: transform.FromField("NextHopType"), Description: "Type of the next hop (ADDRESS/GATEWAY)."},
+			{Name: "next_hop_address", Type: proto.ColumnType_INET, Transform: transform.FromField("NextHopAddress").Transform(transform.NullIfZeroValue), Description: "Next hop IP address, e.g. of a self-managed appliance VM."},
+			{Name: "gateway_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("GatewayId").Transform(transform.NullIfZeroValue), Description: "Next hop gateway ID, e.g. of a NAT gateway."},
+			{Name: "labels", Type: proto.ColumnType_JSON, Transform: transform.FromField("Labels"), Description: "Route labels as key:value pairs."},
+		},
+	}
+}
+
+func listYandexVPCRouteTableRoutes(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewVPCClient(tok, 30, cfg)
+
+	var folderIDStr *string
+	if cfg.FolderID != nil {
+		str := string(*cfg.FolderID)
+		folderIDStr = &str
+	}
+	folderID := getQualString(d, "folder_id", folderIDStr)
+	networkID := getQualString(d, "network_id", nil)
+	if folderID == "" && networkID == "" {
+		return nil, fmt.Errorf("folder_id or network_id must be provided")
+	}
+
+	var filters []string
+	if id := getQualString(d, "route_table_id", nil); id != "" {
+		filters = append(filters, fmt.Sprintf("(id = \"%s\")", id))
+	}
+	if netid := getQualString(d, "network_id", nil); netid != "" {
+		filters = append(filters, fmt.Sprintf("(networkId = \"%s\")", netid))
+	}
+	gatewayID := getQualString(d, "gateway_id", nil)
+
+	pageToken := ""
+	pageSize := int64(1000)
+	for {
+		routeTables, nextPageToken, err := listVPCRouteTablesPage(ctx, client, folderID, networkID, pageToken, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, rt := range routeTables {
+			if len(filters) > 0 {
+				if !vpcRouteTableMatchesFilters(rt, filters) {
+					continue
+				}
+			}
+			for _, route := range vpcRouteTableRoutes(rt) {
+				if gatewayID != "" && route.GatewayId != gatewayID {
+					continue
+				}
+				d.StreamListItem(ctx, route)
+			}
+		}
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return nil, nil
+}
+
+func vpcRouteTableRoutes(rt *VPCRouteTable) []*VPCRouteTableRoute {
+	routes := make([]*VPCRouteTableRoute, 0, len(rt.StaticRoutes))
+	for _, sr := range rt.StaticRoutes {
+		route := &VPCRouteTableRoute{
+			RouteTableId:      rt.Id,
+			RouteTableName:    rt.Name,
+			NetworkId:         rt.NetworkId,
+			FolderId:          rt.FolderId,
+			DestinationPrefix: sr.DestinationPrefix,
+			NextHopAddress:    sr.NextHopAddress,
+			GatewayId:         sr.GatewayId,
+			Labels:            sr.Labels,
+		}
+		if sr.GatewayId != "" {
+			route.NextHopType = RouteNextHopTypeGateway
+		} else {
+			route.NextHopType = RouteNextHopTypeAddress
+		}
+		routes = append(routes, route)
+	}
+	return routes
+}
@@ -0,0 +1,42 @@
+package yandexcloud
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestVPCRouteTableRoutes(t *testing.T) {
+	raw := `{
+		"id": "rt1",
+		"folderId": "folder1",
+		"networkId": "net1",
+		"name": "egress",
+		"staticRoutes": [
+			{"destinationPrefix": "0.0.0.0/0", "gatewayId": "gw1"},
+			{"destinationPrefix": "10.20.0.0/16", "nextHopAddress": "10.0.0.10", "labels": {"via": "appliance"}}
+		]
+	}`
+	var rt VPCRouteTable
+	if err := json.Unmarshal([]byte(raw), &rt); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	routes := vpcRouteTableRoutes(&rt)
+	if len(routes) != 2 {
+		t.Fatalf("expected 2 routes, got %d", len(routes))
+	}
+	gw := routes[0]
+	if gw.NextHopType != RouteNextHopTypeGateway || gw.GatewayId != "gw1" || gw.NextHopAddress != "" || gw.DestinationPrefix != "0.0.0.0/0" {
+		t.Errorf("unexpected gateway route: %+v", gw)
+	}
+	if gw.RouteTableId != "rt1" || gw.RouteTableName != "egress" || gw.NetworkId != "net1" || gw.FolderId != "folder1" {
+		t.Errorf("unexpected route table fields: %+v", gw)
+	}
+	hop := routes[1]
+	if hop.NextHopType != RouteNextHopTypeAddress || hop.NextHopAddress != "10.0.0.10" || hop.GatewayId != "" || hop.Labels["via"] != "appliance" {
+		t.Errorf("unexpected next hop route: %+v", hop)
+	}
+
+	if routes := vpcRouteTableRoutes(&VPCRouteTable{Id: "rt2"}); len(routes) != 0 {
+		t.Errorf("expected no routes, got %d", len(routes))
+	}
+}
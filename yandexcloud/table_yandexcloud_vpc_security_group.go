@@ -30,9 +30,11 @@ func tableYandexVPCSecurityGroup(_ context.Context) *plugin.Table {
 			{Name: "description", Type: proto.ColumnType_STRING, Transform: transform.FromField("Description"), Description: "Security group description."},
 			{Name: "created_at", Type: proto.ColumnType_STRING, Transform: transform.From(createdAtVPCSecurityGroupDateTransform), Description: "Security group creation date (YYYY-MM-DD)."},
 			{Name: "labels", Type: proto.ColumnType_JSON, Transform: transform.FromField("Labels"), Description: "Resource labels as key:value pairs."},
-			{Name: "rules", Type: proto.ColumnType_JSON, Transform: transform.FromField("Rules"), Description: "All rules (deprecated, use ingress/egress)."},
-			{Name: "ingress_rules", Type: proto.ColumnType_JSON, Transform: transform.FromField("IngressRules"), Description: "Ingress rules."},
-			{Name: "egress_rules", Type: proto.ColumnType_JSON, Transform: transform.FromField("EgressRules"), Description: "Egress rules."},
+			{Name: "status", Type: proto.ColumnType_STRING, Transform: transform.FromField("Status"), Description: "Security group status."},
+			{Name: "default_for_network", Type: proto.ColumnType_BOOL, Transform: transform.FromField("DefaultForNetwork"), Description: "Whether this is the default security group of the network."},
+			{Name: "rules", Type: proto.ColumnType_JSON, Transform: transform.FromField("Rules"), Description: "All rules of the security group."},
+			{Name: "ingress_rules", Type: proto.ColumnType_JSON, Transform: transform.From(vpcSecurityGroupIngressRulesTransform), Description: "Ingress rules."},
+			{Name: "egress_rules", Type: proto.ColumnType_JSON, Transform: transform.From(vpcSecurityGroupEgressRulesTransform), Description: "Egress rules."},
 		},
 	}
 }
@@ -51,8 +53,9 @@ func listYandexVPCSecurityGroups(ctx context.Context, d *plugin.QueryData, _ *pl
 		folderIDStr = &str
 	}
 	folderID := getQualString(d, "folder_id", folderIDStr)
-	if folderID == "" {
-		return nil, fmt.Errorf("folder_id must be provided")
+	networkID := getQualString(d, "network_id", nil)
+	if folderID == "" && networkID == "" {
+		return nil, fmt.Errorf("folder_id or network_id must be provided")
 	}
 
 	var filters []string
@@ -72,7 +75,7 @@ func listYandexVPCSecurityGroups(ctx context.Context, d *plugin.QueryData, _ *pl
 	pageToken := ""
 	pageSize := int64(1000)
 	for {
-		groups, nextPageToken, err := client.ListVPCSecurityGroups(ctx, folderID, pageToken, pageSize)
+		groups, nextPageToken, err := listVPCSecurityGroupsPage(ctx, client, folderID, networkID, pageToken, pageSize)
 		if err != nil {
 			return nil, err
 		}
@@ -120,6 +123,15 @@ func getYandexVPCSecurityGroup(ctx context.Context, d *plugin.QueryData, h *plug
 	return group, nil
 }
 
+// listVPCSecurityGroupsPage returns a page of security groups of the network when networkID is set,
+// using the network-scoped list method, and of the folder otherwise.
+func listVPCSecurityGroupsPage(ctx context.Context, client VPCClient, folderID, networkID string, pageToken string, pageSize int64) ([]*VPCSecurityGroup, string, error) {
+	if networkID != "" {
+		return client.ListVPCNetworkSecurityGroups(ctx, VPCNetworkID(networkID), pageToken, pageSize)
+	}
+	return client.ListVPCSecurityGroups(ctx, folderID, pageToken, pageSize)
+}
+
 // Manual filtering, since the API does not support filters except folderId
 func vpcSecurityGroupMatchesFilters(group *VPCSecurityGroup, filters []string) bool {
 	for _, f := range filters {
@@ -139,6 +151,34 @@ func vpcSecurityGroupMatchesFilters(group *VPCSecurityGroup, filters []string) b
 	return true
 }
 
+// Transform function for ingress_rules: returns the rules with INGRESS direction
+func vpcSecurityGroupIngressRulesTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	group, ok := d.HydrateItem.(*VPCSecurityGroup)
+	if !ok {
+		return nil, nil
+	}
+	return vpcSecurityGroupRulesByDirection(group, SecurityGroupRuleDirectionIngress), nil
+}
+
+// Transform function for egress_rules: returns the rules with EGRESS direction
+func vpcSecurityGroupEgressRulesTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	group, ok := d.HydrateItem.(*VPCSecurityGroup)
+	if !ok {
+		return nil, nil
+	}
+	return vpcSecurityGroupRulesByDirection(group, SecurityGroupRuleDirectionEgress), nil
+}
+
+func vpcSecurityGroupRulesByDirection(group *VPCSecurityGroup, direction string) []SecurityGroupRule {
+	rules := []SecurityGroupRule{}
+	for _, rule := range group.Rules {
+		if rule.Direction == direction {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
 // Transform function for created_at: returns only the date (YYYY-MM-DD)
 func createdAtVPCSecurityGroupDateTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
 	if d.HydrateItem == nil {
@@ -0,0 +1,164 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// VPCSecurityGroupRuleRow is a security group rule expanded to one row per CIDR block.
+// Rules without CIDR blocks (security group or predefined targets) produce a single row with an empty Cidr.
+type VPCSecurityGroupRuleRow struct {
+	SecurityGroupId       string
+	SecurityGroupName     string
+	NetworkId             string
+	FolderId              string
+	RuleId                string
+	Direction             string
+	Description           string
+	Labels                map[string]string
+	ProtocolName          string
+	ProtocolNumber        string
+	FromPort              int64
+	ToPort                int64
+	Cidr                  string
+	IpVersion             string
+	TargetSecurityGroupId string
+	PredefinedTarget      string
+}
+
+func tableYandexVPCSecurityGroupRule(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_vpc_security_group_rule",
+		Description: "Yandex Cloud VPC security group rules, one row per rule and CIDR block.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "security_group_id", "network_id", "direction"}),
+			Hydrate:    listYandexVPCSecurityGroupRules,
+		},
+		Columns: []*plugin.Column{
+			{Name: "security_group_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("SecurityGroupId"), Description: "ID of the security group the rule belongs to."},
+			{Name: "security_group_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("SecurityGroupName"), Description: "Name of the security group the rule belongs to."},
+			{Name: "network_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("NetworkId"), Description: "Network ID of the security group."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId"), Description: "Folder ID containing the security group."},
+			{Name: "rule_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("RuleId"), Description: "Rule ID."},
+			{Name: "direction", Type: proto.ColumnType_STRING, Transform: transform.FromField("Direction"), Description: "Traffic direction (INGRESS/EGRESS)."},
+			{Name: "description", Type: proto.ColumnType_STRING, Transform: transform.FromField("Description"), Description: "Rule description."},
+			{Name: "labels", Type: proto.ColumnType_JSON, Transform: transform.FromField("Labels"), Description: "Rule labels as key:value pairs."},
+			{Name: "protocol", Type: proto.ColumnType_STRING, Transform: transform.FromField("ProtocolName"), Description: "Protocol name (e.g. TCP, UDP, ICMP, ANY)."},
+			{Name: "protocol_number", Type: proto.ColumnType_INT, Transform: transform.FromField("ProtocolNumber").Transform(transform.NullIfZeroValue), Description: "IANA protocol number."},
+			{Name: "from_port", Type: proto.ColumnType_INT, Transform: transform.FromField("FromPort"), Description: "First port of the range; 0 if the rule applies to all ports."},
+			{Name: "to_port", Type: proto.ColumnType_INT, Transform: transform.FromField("ToPort"), Description: "Last port of the range; 65535 if the rule applies to all ports."},
+			{Name: "cidr", Type: proto.ColumnType_CIDR, Transform: transform.FromField("Cidr").Transform(transform.NullIfZeroValue), Description: "CIDR block the rule applies to."},
+			{Name: "ip_version", Type: proto.ColumnType_STRING, Transform: transform.FromField("IpVersion").Transform(transform.NullIfZeroValue), Description: "IP version of the CIDR block (IPV4/IPV6)."},
+			{Name: "target_security_group_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("TargetSecurityGroupId").Transform(transform.NullIfZeroValue), Description: "Security group referenced by the rule."},
+			{Name: "predefined_target", Type: proto.ColumnType_STRING, Transform: transform.FromField("PredefinedTarget").Transform(transform.NullIfZeroValue), Description: "Predefined target (e.g. self_security_group, loadbalancer_healthchecks)."},
+		},
+	}
+}
+
+func listYandexVPCSecurityGroupRules(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	tok, err := getAuthToken(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := NewVPCClient(tok, 30, cfg)
+
+	var folderIDStr *string
+	if cfg.FolderID != nil {
+		str := string(*cfg.FolderID)
+		folderIDStr = &str
+	}
+	folderID := getQualString(d, "folder_id", folderIDStr)
+	networkID := getQualString(d, "network_id", nil)
+	if folderID == "" && networkID == "" {
+		return nil, fmt.Errorf("folder_id or network_id must be provided")
+	}
+
+	var filters []string
+	if id := getQualString(d, "security_group_id", nil); id != "" {
+		filters = append(filters, fmt.Sprintf("(id = \"%s\")", id))
+	}
+	if netid := getQualString(d, "network_id", nil); netid != "" {
+		filters = append(filters, fmt.Sprintf("(networkId = \"%s\")", netid))
+	}
+	direction := strings.ToUpper(getQualString(d, "direction", nil))
+
+	pageToken := ""
+	pageSize := int64(1000)
+	for {
+		groups, nextPageToken, err := listVPCSecurityGroupsPage(ctx, client, folderID, networkID, pageToken, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, group := range groups {
+			if len(filters) > 0 {
+				if !vpcSecurityGroupMatchesFilters(group, filters) {
+					continue
+				}
+			}
+			for _, row := range vpcSecurityGroupRuleRows(group) {
+				if direction != "" && row.Direction != direction {
+					continue
+				}
+				d.StreamListItem(ctx, row)
+			}
+		}
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return nil, nil
+}
+
+// vpcSecurityGroupRuleRows expands the rules of a security group into rows.
+// A rule without a port range applies to all ports and is reported as 0-65535.
+func vpcSecurityGroupRuleRows(group *VPCSecurityGroup) []*VPCSecurityGroupRuleRow {
+	var rows []*VPCSecurityGroupRuleRow
+	for _, rule := range group.Rules {
+		base := VPCSecurityGroupRuleRow{
+			SecurityGroupId:       group.Id,
+			SecurityGroupName:     group.Name,
+			NetworkId:             group.NetworkId,
+			FolderId:              group.FolderId,
+			RuleId:                rule.Id,
+			Direction:             rule.Direction,
+			Description:           rule.Description,
+			Labels:                rule.Labels,
+			ProtocolName:          rule.ProtocolName,
+			ProtocolNumber:        rule.ProtocolNumber,
+			FromPort:              0,
+			ToPort:                65535,
+			TargetSecurityGroupId: rule.SecurityGroupId,
+			PredefinedTarget:      rule.PredefinedTarget,
+		}
+		if rule.Ports != nil {
+			base.FromPort = rule.Ports.FromPort
+			base.ToPort = rule.Ports.ToPort
+		}
+		var cidrRows []*VPCSecurityGroupRuleRow
+		if rule.CidrBlocks != nil {
+			for _, cidr := range rule.CidrBlocks.V4CidrBlocks {
+				row := base
+				row.Cidr, row.IpVersion = cidr, "IPV4"
+				cidrRows = append(cidrRows, &row)
+			}
+			for _, cidr := range rule.CidrBlocks.V6CidrBlocks {
+				row := base
+				row.Cidr, row.IpVersion = cidr, "IPV6"
+				cidrRows = append(cidrRows, &row)
+			}
+		}
+		if len(cidrRows) == 0 {
+			row := base
+			cidrRows = append(cidrRows, &row)
+		}
+		rows = append(rows, cidrRows...)
+	}
+	return rows
+}
@@ -0,0 +1,51 @@
+package yandexcloud
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestVPCSecurityGroupRuleRows(t *testing.T) {
+	raw := `{
+		"id": "sg1",
+		"name": "web",
+		"networkId": "net1",
+		"rules": [
+			{
+				"id": "r1",
+				"direction": "INGRESS",
+				"ports": {"fromPort": "22", "toPort": "22"},
+				"protocolName": "TCP",
+				"protocolNumber": "6",
+				"cidrBlocks": {"v4CidrBlocks": ["0.0.0.0/0", "10.0.0.0/8"], "v6CidrBlocks": ["::/0"]}
+			},
+			{
+				"id": "r2",
+				"direction": "EGRESS",
+				"protocolName": "ANY",
+				"securityGroupId": "sg2"
+			}
+		]
+	}`
+	var group VPCSecurityGroup
+	if err := json.Unmarshal([]byte(raw), &group); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rows := vpcSecurityGroupRuleRows(&group)
+	if len(rows) != 4 {
+		t.Fatalf("expected 4 rows, got %d", len(rows))
+	}
+	if rows[0].Cidr != "0.0.0.0/0" || rows[0].IpVersion != "IPV4" || rows[0].FromPort != 22 || rows[0].ToPort != 22 {
+		t.Errorf("unexpected first row: %+v", rows[0])
+	}
+	if rows[2].Cidr != "::/0" || rows[2].IpVersion != "IPV6" {
+		t.Errorf("unexpected IPv6 row: %+v", rows[2])
+	}
+	egress := rows[3]
+	if egress.Cidr != "" || egress.TargetSecurityGroupId != "sg2" || egress.FromPort != 0 || egress.ToPort != 65535 {
+		t.Errorf("unexpected egress row: %+v", egress)
+	}
+	if got := vpcSecurityGroupRulesByDirection(&group, SecurityGroupRuleDirectionIngress); len(got) != 1 || got[0].Id != "r1" {
+		t.Errorf("unexpected ingress rules: %+v", got)
+	}
+}
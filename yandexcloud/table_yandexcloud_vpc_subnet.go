@@ -3,6 +3,7 @@ package yandexcloud
 import (
 	"context"
 	"fmt"
+	"net"
 	"strings"
 
 	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
@@ -15,7 +16,7 @@ func tableYandexVPCSubnet(_ context.Context) *plugin.Table {
 		Name:        "yandexcloud_vpc_subnet",
 		Description: "Yandex Cloud VPC subnets.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "subnet_id", "network_id", "zone_id", "name", "description"}),
+			KeyColumns: plugin.OptionalColumns([]string{"folder_id", "subnet_id", "network_id", "zone_id", "name", "description", "route_table_id"}),
 			Hydrate:    listYandexVPCSubnets,
 		},
 		Get: &plugin.GetConfig{
@@ -32,6 +33,12 @@ func tableYandexVPCSubnet(_ context.Context) *plugin.Table {
 			{Name: "created_at", Type: proto.ColumnType_STRING, Transform: transform.From(createdAtVPCSubnetDateTransform), Description: "Subnet creation date (YYYY-MM-DD)."},
 			{Name: "labels", Type: proto.ColumnType_JSON, Transform: transform.FromField("Labels"), Description: "Resource labels as key:value pairs."},
 			{Name: "cidr_blocks", Type: proto.ColumnType_JSON, Transform: transform.FromField("CidrBlocks"), Description: "List of IPv4 CIDR blocks assigned to the subnet."},
+			{Name: "v4_address_count", Type: proto.ColumnType_INT, Transform: transform.From(vpcSubnetV4AddressCountTransform), Description: "Total number of addresses in the IPv4 CIDR blocks."},
+			{Name: "v6_cidr_blocks", Type: proto.ColumnType_JSON, Transform: transform.FromField("V6CidrBlocks"), Description: "List of IPv6 CIDR blocks assigned to the subnet."},
+			{Name: "route_table_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("RouteTableId"), Description: "ID of the route table associated with the subnet."},
+			{Name: "dhcp_domain_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("DhcpOptions.DomainName"), Description: "Domain name passed to instances by DHCP."},
+			{Name: "dhcp_domain_name_servers", Type: proto.ColumnType_JSON, Transform: transform.FromField("DhcpOptions.DomainNameServers"), Description: "DNS servers passed to instances by DHCP."},
+			{Name: "dhcp_ntp_servers", Type: proto.ColumnType_JSON, Transform: transform.FromField("DhcpOptions.NtpServers"), Description: "NTP servers passed to instances by DHCP."},
 		},
 	}
 }
@@ -50,8 +57,9 @@ func listYandexVPCSubnets(ctx context.Context, d *plugin.QueryData, _ *plugin.Hy
 		folderIDStr = &str
 	}
 	folderID := getQualString(d, "folder_id", folderIDStr)
-	if folderID == "" {
-		return nil, fmt.Errorf("folder_id must be provided")
+	networkID := getQualString(d, "network_id", nil)
+	if folderID == "" && networkID == "" {
+		return nil, fmt.Errorf("folder_id or network_id must be provided")
 	}
 
 	var filters []string
@@ -70,11 +78,14 @@ func listYandexVPCSubnets(ctx context.Context, d *plugin.QueryData, _ *plugin.Hy
 	if dsc := getQualString(d, "description", nil); dsc != "" {
 		filters = append(filters, fmt.Sprintf("(description = \"%s\")", dsc))
 	}
+	if rt := getQualString(d, "route_table_id", nil); rt != "" {
+		filters = append(filters, fmt.Sprintf("(routeTableId = \"%s\")", rt))
+	}
 
 	pageToken := ""
 	pageSize := int64(1000)
 	for {
-		subnets, nextPageToken, err := client.ListVPCSubnets(ctx, folderID, pageToken, pageSize)
+		subnets, nextPageToken, err := listVPCSubnetsPage(ctx, client, folderID, networkID, pageToken, pageSize)
 		if err != nil {
 			return nil, err
 		}
@@ -122,6 +133,15 @@ func getYandexVPCSubnet(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 	return subnet, nil
 }
 
+// listVPCSubnetsPage returns a page of subnets of the network when networkID is set,
+// using the network-scoped list method, and of the folder otherwise.
+func listVPCSubnetsPage(ctx context.Context, client VPCClient, folderID, networkID string, pageToken string, pageSize int64) ([]*VPCSubnet, string, error) {
+	if networkID != "" {
+		return client.ListVPCNetworkSubnets(ctx, VPCNetworkID(networkID), pageToken, pageSize)
+	}
+	return client.ListVPCSubnets(ctx, folderID, pageToken, pageSize)
+}
+
 // Manual filtering, since the API does not support filters except folderId
 func vpcSubnetMatchesFilters(subnet *VPCSubnet, filters []string) bool {
 	for _, f := range filters {
@@ -140,10 +160,31 @@ func vpcSubnetMatchesFilters(subnet *VPCSubnet, filters []string) bool {
 		if strings.HasPrefix(f, "(description = ") && !strings.Contains(f, subnet.Description) {
 			return false
 		}
+		if strings.HasPrefix(f, "(routeTableId = ") && !strings.Contains(f, subnet.RouteTableId) {
+			return false
+		}
 	}
 	return true
 }
 
+// Transform function for v4_address_count: sums the sizes of the IPv4 CIDR blocks
+func vpcSubnetV4AddressCountTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	subnet, ok := d.HydrateItem.(*VPCSubnet)
+	if !ok {
+		return nil, nil
+	}
+	var total int64
+	for _, cidr := range subnet.CidrBlocks {
+		_, block, err := net.ParseCIDR(cidr)
+		if err != nil {
+			continue
+		}
+		ones, bits := block.Mask.Size()
+		total += int64(1) << uint(bits-ones)
+	}
+	return total, nil
+}
+
 // Transform function for created_at: returns only the date (YYYY-MM-DD)
 func createdAtVPCSubnetDateTransform(_ context.Context, d *transform.TransformData) (interface{}, error) {
 	if d.HydrateItem == nil {
@@ -0,0 +1,70 @@
+package yandexcloud
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestVPCSubnetColumns(t *testing.T) {
+	raw := `{
+		"id": "subnet1",
+		"v4CidrBlocks": ["10.0.0.0/24", "10.1.0.0/28"],
+		"v6CidrBlocks": ["fd00::/64"],
+		"routeTableId": "rt1",
+		"dhcpOptions": {"domainNameServers": ["10.0.0.2"], "domainName": "corp.internal", "ntpServers": ["10.0.0.3"]}
+	}`
+	var subnet VPCSubnet
+	if err := json.Unmarshal([]byte(raw), &subnet); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	table := tableYandexVPCSubnet(context.Background())
+	if got := columnValue(t, table, "v4_address_count", &subnet); got != int64(256+16) {
+		t.Errorf("v4_address_count = %v", got)
+	}
+	if got := columnValue(t, table, "v6_cidr_blocks", &subnet); !reflect.DeepEqual(got, []string{"fd00::/64"}) {
+		t.Errorf("v6_cidr_blocks = %v", got)
+	}
+	if got := columnValue(t, table, "route_table_id", &subnet); got != "rt1" {
+		t.Errorf("route_table_id = %v", got)
+	}
+	if got := columnValue(t, table, "dhcp_domain_name", &subnet); got != "corp.internal" {
+		t.Errorf("dhcp_domain_name = %v", got)
+	}
+	if got := columnValue(t, table, "dhcp_domain_name_servers", &subnet); !reflect.DeepEqual(got, []string{"10.0.0.2"}) {
+		t.Errorf("dhcp_domain_name_servers = %v", got)
+	}
+	if got := columnValue(t, table, "dhcp_ntp_servers", &subnet); !reflect.DeepEqual(got, []string{"10.0.0.3"}) {
+		t.Errorf("dhcp_ntp_servers = %v", got)
+	}
+}
+
+func TestVPCSubnetUsedAddressRows(t *testing.T) {
+	raw := `{"addresses": [
+		{"address": "10.0.0.5", "ipVersion": "IPV4", "references": [
+			{"referrer": {"type": "compute.instance", "id": "inst1"}, "type": "USED_BY"},
+			{"referrer": {"type": "vpc.subnet", "id": "subnet1"}, "type": "MANAGED_BY"}
+		]},
+		{"address": "10.0.0.1", "ipVersion": "IPV4"}
+	]}`
+	var resp ListVPCSubnetUsedAddressesResponse
+	if err := json.Unmarshal([]byte(raw), &resp); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	subnet := &VPCSubnet{Id: "subnet1", Name: "app", NetworkId: "net1", ZoneId: "ru-central1-a", FolderId: "folder1"}
+	rows := vpcSubnetUsedAddressRows(subnet, resp.Addresses)
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(rows))
+	}
+	used := rows[0]
+	if used.SubnetId != "subnet1" || used.SubnetName != "app" || used.NetworkId != "net1" || used.ZoneId != "ru-central1-a" || used.FolderId != "folder1" {
+		t.Errorf("unexpected subnet fields: %+v", used)
+	}
+	if used.ReferrerType != "compute.instance" || used.ReferrerId != "inst1" || used.ReferenceType != "USED_BY" || len(used.References) != 2 {
+		t.Errorf("unexpected references: %+v", used)
+	}
+	if free := rows[1]; free.Address != "10.0.0.1" || free.ReferrerId != "" || free.ReferenceType != "" {
+		t.Errorf("unexpected unreferenced address: %+v", free)
+	}
+}
@@ -0,0 +1,156 @@
+package yandexcloud
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// YMQQueueRow is a message queue with its attributes.
+type YMQQueueRow struct {
+	QueueUrl                              string
+	Name                                  string
+	QueueArn                              string
+	FolderId                              string
+	FifoQueue                             bool
+	ContentBasedDeduplication             bool
+	VisibilityTimeout                     *int64
+	MessageRetentionPeriod                *int64
+	MaximumMessageSize                    *int64
+	DelaySeconds                          *int64
+	ReceiveMessageWaitTimeSeconds         *int64
+	RedrivePolicy                         map[string]interface{}
+	DeadLetterTargetArn                   string
+	MaxReceiveCount                       *int64
+	ApproximateNumberOfMessages           *int64
+	ApproximateNumberOfMessagesNotVisible *int64
+	ApproximateNumberOfMessagesDelayed    *int64
+	CreatedAt                             string
+	LastModifiedAt                        string
+	Attributes                            map[string]string
+}
+
+func tableYandexYMQQueue(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "yandexcloud_ymq_queue",
+		Description: "Yandex Message Queue queues, read through the SQS-compatible API with a static access key.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"name"}),
+			Hydrate:    listYandexYMQQueues,
+		},
+		Columns: []*plugin.Column{
+			{Name: "queue_url", Type: proto.ColumnType_STRING, Transform: transform.FromField("QueueUrl"), Description: "Queue URL."},
+			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name"), Description: "Queue name."},
+			{Name: "queue_arn", Type: proto.ColumnType_STRING, Transform: transform.FromField("QueueArn"), Description: "Queue ARN (YRN), used in redrive policies."},
+			{Name: "folder_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("FolderId").Transform(transform.NullIfZeroValue), Description: "Folder ID containing the queue, taken from the queue ARN."},
+			{Name: "fifo_queue", Type: proto.ColumnType_BOOL, Transform: transform.FromField("FifoQueue"), Description: "Whether the queue is a FIFO queue."},
+			{Name: "content_based_deduplication", Type: proto.ColumnType_BOOL, Transform: transform.FromField("ContentBasedDeduplication"), Description: "Whether content-based deduplication is enabled for a FIFO queue."},
+			{Name: "visibility_timeout", Type: proto.ColumnType_INT, Transform: transform.FromField("VisibilityTimeout"), Description: "Visibility timeout, in seconds."},
+			{Name: "message_retention_period", Type: proto.ColumnType_INT, Transform: transform.FromField("MessageRetentionPeriod"), Description: "Message retention period, in seconds."},
+			{Name: "maximum_message_size", Type: proto.ColumnType_INT, Transform: transform.FromField("MaximumMessageSize"), Description: "Maximum message size, in bytes."},
+			{Name: "delay_seconds", Type: proto.ColumnType_INT, Transform: transform.FromField("DelaySeconds"), Description: "Default delivery delay, in seconds."},
+			{Name: "receive_message_wait_time_seconds", Type: proto.ColumnType_INT, Transform: transform.FromField("ReceiveMessageWaitTimeSeconds"), Description: "Long polling wait time, in seconds."},
+			{Name: "redrive_policy", Type: proto.ColumnType_JSON, Transform: transform.FromField("RedrivePolicy"), Description: "Dead letter queue redrive policy."},
+			{Name: "dead_letter_target_arn", Type: proto.ColumnType_STRING, Transform: transform.FromField("DeadLetterTargetArn").Transform(transform.NullIfZeroValue), Description: "ARN of the dead letter queue."},
+			{Name: "max_receive_count", Type: proto.ColumnType_INT, Transform: transform.FromField("MaxReceiveCount"), Description: "Number of receives after which a message moves to the dead letter queue."},
+			{Name: "approximate_number_of_messages", Type: proto.ColumnType_INT, Transform: transform.FromField("ApproximateNumberOfMessages"), Description: "Approximate number of messages available for retrieval."},
+			{Name: "approximate_number_of_messages_not_visible", Type: proto.ColumnType_INT, Transform: transform.FromField("ApproximateNumberOfMessagesNotVisible"), Description: "Approximate number of messages in flight."},
+			{Name: "approximate_number_of_messages_delayed", Type: proto.ColumnType_INT, Transform: transform.FromField("ApproximateNumberOfMessagesDelayed"), Description: "Approximate number of delayed messages."},
+			{Name: "created_at", Type: proto.ColumnType_STRING, Transform: transform.FromField("CreatedAt").Transform(transform.NullIfZeroValue), Description: "Queue creation date (YYYY-MM-DD)."},
+			{Name: "last_modified_at", Type: proto.ColumnType_STRING, Transform: transform.FromField("LastModifiedAt").Transform(transform.NullIfZeroValue), Description: "Date the queue attributes were last changed (YYYY-MM-DD)."},
+			{Name: "attributes", Type: proto.ColumnType_JSON, Transform: transform.FromField("Attributes"), Description: "All queue attributes as returned by the API."},
+		},
+	}
+}
+
+func listYandexYMQQueues(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	cfg := getConfig(d)
+	client, err := NewYMQClient(30, cfg)
+	if err != nil {
+		return nil, err
+	}
+	name := getQualString(d, "name", nil)
+
+	queueURLs, err := client.ListYMQQueues(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	for _, queueURL := range queueURLs {
+		if name != "" && ymqQueueName(queueURL) != name {
+			continue
+		}
+		attributes, err := client.GetYMQQueueAttributes(ctx, queueURL)
+		if err != nil {
+			return nil, err
+		}
+		d.StreamListItem(ctx, ymqQueueRow(queueURL, attributes))
+	}
+	return nil, nil
+}
+
+func ymqQueueRow(queueURL string, attributes map[string]string) *YMQQueueRow {
+	row := &YMQQueueRow{
+		QueueUrl:                              queueURL,
+		Name:                                  ymqQueueName(queueURL),
+		QueueArn:                              attributes["QueueArn"],
+		FifoQueue:                             attributes["FifoQueue"] == "true",
+		ContentBasedDeduplication:             attributes["ContentBasedDeduplication"] == "true",
+		VisibilityTimeout:                     ymqIntAttribute(attributes, "VisibilityTimeout"),
+		MessageRetentionPeriod:                ymqIntAttribute(attributes, "MessageRetentionPeriod"),
+		MaximumMessageSize:                    ymqIntAttribute(attributes, "MaximumMessageSize"),
+		DelaySeconds:                          ymqIntAttribute(attributes, "DelaySeconds"),
+		ReceiveMessageWaitTimeSeconds:         ymqIntAttribute(attributes, "ReceiveMessageWaitTimeSeconds"),
+		ApproximateNumberOfMessages:           ymqIntAttribute(attributes, "ApproximateNumberOfMessages"),
+		ApproximateNumberOfMessagesNotVisible: ymqIntAttribute(attributes, "ApproximateNumberOfMessagesNotVisible"),
+		ApproximateNumberOfMessagesDelayed:    ymqIntAttribute(attributes, "ApproximateNumberOfMessagesDelayed"),
+		Attributes:                            attributes,
+	}
+	// The ARN has the form yrn:yc:ymq:<region>:<folder_id>:<queue_name>.
+	if parts := strings.Split(row.QueueArn, ":"); len(parts) == 6 {
+		row.FolderId = parts[4]
+	}
+	if policy := attributes["RedrivePolicy"]; policy != "" {
+		if err := json.Unmarshal([]byte(policy), &row.RedrivePolicy); err == nil {
+			row.DeadLetterTargetArn, _ = row.RedrivePolicy["deadLetterTargetArn"].(string)
+			switch count := row.RedrivePolicy["maxReceiveCount"].(type) {
+			case float64:
+				v := int64(count)
+				row.MaxReceiveCount = &v
+			case string:
+				if v, err := strconv.ParseInt(count, 10, 64); err == nil {
+					row.MaxReceiveCount = &v
+				}
+			}
+		}
+	}
+	if created := ymqIntAttribute(attributes, "CreatedTimestamp"); created != nil {
+		row.CreatedAt = time.Unix(*created, 0).UTC().Format("2006-01-02")
+	}
+	if modified := ymqIntAttribute(attributes, "LastModifiedTimestamp"); modified != nil {
+		row.LastModifiedAt = time.Unix(*modified, 0).UTC().Format("2006-01-02")
+	}
+	return row
+}
+
+// ymqQueueName returns the last path segment of a queue URL.
+func ymqQueueName(queueURL string) string {
+	return queueURL[strings.LastIndex(queueURL, "/")+1:]
+}
+
+func ymqIntAttribute(attributes map[string]string, name string) *int64 {
+	value, ok := attributes[name]
+	if !ok {
+		return nil
+	}
+	v, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &v
+}
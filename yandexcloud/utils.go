@@ -118,6 +118,10 @@ func getConfig(d *plugin.QueryData) *Config {
 		fid := FolderID(*((*string)(c.FolderID)))
 		c.FolderID = &fid
 	}
+	if c.OrganizationID != nil {
+		oid := OrganizationID(*((*string)(c.OrganizationID)))
+		c.OrganizationID = &oid
+	}
 	if c.UserAgent != nil {
 		u := UserAgent(*((*string)(c.UserAgent)))
 		c.UserAgent = &u
@@ -147,6 +151,53 @@ func getQualString(d *plugin.QueryData, column string, defaultValue *string) str
 	return ""
 }
 
+// getQualTimeRange extracts the bounds of the quals on a timestamp column.
+// Zero times are returned for missing bounds.
+func getQualTimeRange(d *plugin.QueryData, column string) (from time.Time, to time.Time) {
+	q, ok := d.Quals[column]
+	if !ok {
+		return
+	}
+	for _, qual := range q.Quals {
+		ts := qual.Value.GetTimestampValue()
+		if ts == nil {
+			continue
+		}
+		t := ts.AsTime()
+		switch qual.Operator {
+		case "=":
+			from, to = t, t
+		case ">", ">=":
+			if from.IsZero() || t.After(from) {
+				from = t
+			}
+		case "<", "<=":
+			if to.IsZero() || t.Before(to) {
+				to = t
+			}
+		}
+	}
+	return
+}
+
+// collectPages calls a paged list method until the last page and returns all items.
+func collectPages[T any](list func(pageToken string) ([]T, string, error)) ([]T, error) {
+	var all []T
+	pageToken := ""
+	for {
+		items, nextPageToken, err := list(pageToken)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return all, nil
+}
+
 // httpClientCache caches http.Client by timeout.
 var httpClientCache sync.Map // map[int64]*http.Client
 
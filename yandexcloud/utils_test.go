@@ -112,3 +112,34 @@ func TestGetYandexBillingAccount_NoToken(t *testing.T) {
 		t.Errorf("expected error for missing token, got item=%v, err=%v", item, err)
 	}
 }
+
+// columnValue resolves a column of a table for a row through the column's transforms.
+func columnValue(t *testing.T, table *plugin.Table, column string, item interface{}) interface{} {
+	t.Helper()
+	ctx := context.WithValue(context.Background(), context_key.Logger, hclog.NewNullLogger())
+	for _, col := range table.Columns {
+		if col.Name != column {
+			continue
+		}
+		val, err := col.Transform.Execute(ctx, &transform.TransformData{HydrateItem: item, ColumnName: column})
+		if err != nil {
+			t.Fatalf("column %s: unexpected error: %v", column, err)
+		}
+		return val
+	}
+	t.Fatalf("table %s has no column %s", table.Name, column)
+	return nil
+}
+
+func TestGetConfig_LoneAccessKeyID(t *testing.T) {
+	token, key := Token("t1"), AccessKeyID("AKID")
+	d := &plugin.QueryData{Connection: &plugin.Connection{Config: &Config{Token: &token, AccessKeyID: &key}}}
+	cfg := getConfig(d)
+	if cfg.Token == nil || *cfg.Token != "t1" {
+		t.Fatalf("a half-set static key must not discard the config: %+v", cfg)
+	}
+	// Only the clients that sign with the static key reject it.
+	if _, err := NewYMQClient(5, cfg); err == nil {
+		t.Errorf("expected an error for access_key_id without secret_access_key")
+	}
+}
@@ -11,12 +11,13 @@ import (
 )
 
 type VPCNetwork struct {
-	Id          string            `json:"id"`
-	FolderId    string            `json:"folderId"`
-	Name        string            `json:"name"`
-	Description string            `json:"description"`
-	Labels      map[string]string `json:"labels"`
-	CreatedAt   string            `json:"createdAt"`
+	Id                     string            `json:"id"`
+	FolderId               string            `json:"folderId"`
+	Name                   string            `json:"name"`
+	Description            string            `json:"description"`
+	Labels                 map[string]string `json:"labels"`
+	CreatedAt              string            `json:"createdAt"`
+	DefaultSecurityGroupId string            `json:"defaultSecurityGroupId"`
 }
 
 type VPCNetworkID string
@@ -31,19 +32,50 @@ type GetVPCNetworkResponse struct {
 }
 
 type VPCSubnet struct {
-	Id          string            `json:"id"`
-	FolderId    string            `json:"folderId"`
-	NetworkId   string            `json:"networkId"`
-	ZoneId      string            `json:"zoneId"`
-	Name        string            `json:"name"`
-	Description string            `json:"description"`
-	Labels      map[string]string `json:"labels"`
-	CreatedAt   string            `json:"createdAt"`
-	CidrBlocks  []string          `json:"v4CidrBlocks"`
+	Id           string            `json:"id"`
+	FolderId     string            `json:"folderId"`
+	NetworkId    string            `json:"networkId"`
+	ZoneId       string            `json:"zoneId"`
+	Name         string            `json:"name"`
+	Description  string            `json:"description"`
+	Labels       map[string]string `json:"labels"`
+	CreatedAt    string            `json:"createdAt"`
+	CidrBlocks   []string          `json:"v4CidrBlocks"`
+	V6CidrBlocks []string          `json:"v6CidrBlocks"`
+	RouteTableId string            `json:"routeTableId"`
+	DhcpOptions  *DhcpOptions      `json:"dhcpOptions,omitempty"`
+}
+
+type DhcpOptions struct {
+	DomainNameServers []string `json:"domainNameServers"`
+	DomainName        string   `json:"domainName"`
+	NtpServers        []string `json:"ntpServers"`
 }
 
 type VPCSubnetID string
 
+// VPCUsedAddress is an address of a subnet that is in use.
+type VPCUsedAddress struct {
+	Address    string                 `json:"address"`
+	IpVersion  string                 `json:"ipVersion"`
+	References []UsedAddressReference `json:"references"`
+}
+
+type UsedAddressReference struct {
+	Referrer *UsedAddressReferrer `json:"referrer,omitempty"`
+	Type     string               `json:"type"`
+}
+
+type UsedAddressReferrer struct {
+	Type string `json:"type"`
+	Id   string `json:"id"`
+}
+
+type ListVPCSubnetUsedAddressesResponse struct {
+	Addresses     []*VPCUsedAddress `json:"addresses"`
+	NextPageToken string            `json:"nextPageToken"`
+}
+
 type ListVPCSubnetsResponse struct {
 	Subnets       []*VPCSubnet `json:"subnets"`
 	NextPageToken string       `json:"nextPageToken"`
@@ -54,14 +86,22 @@ type GetVPCSubnetResponse struct {
 }
 
 type VPCRouteTable struct {
-	Id           string                   `json:"id"`
-	FolderId     string                   `json:"folderId"`
-	NetworkId    string                   `json:"networkId"`
-	Name         string                   `json:"name"`
-	Description  string                   `json:"description"`
-	Labels       map[string]string        `json:"labels"`
-	CreatedAt    string                   `json:"createdAt"`
-	StaticRoutes []map[string]interface{} `json:"staticRoutes"`
+	Id           string            `json:"id"`
+	FolderId     string            `json:"folderId"`
+	NetworkId    string            `json:"networkId"`
+	Name         string            `json:"name"`
+	Description  string            `json:"description"`
+	Labels       map[string]string `json:"labels"`
+	CreatedAt    string            `json:"createdAt"`
+	StaticRoutes []StaticRoute     `json:"staticRoutes"`
+}
+
+// StaticRoute is a route of a route table. Exactly one of NextHopAddress and GatewayId is set.
+type StaticRoute struct {
+	DestinationPrefix string            `json:"destinationPrefix"`
+	NextHopAddress    string            `json:"nextHopAddress,omitempty"`
+	GatewayId         string            `json:"gatewayId,omitempty"`
+	Labels            map[string]string `json:"labels,omitempty"`
 }
 
 type VPCRouteTableID string
@@ -76,17 +116,47 @@ type GetVPCRouteTableResponse struct {
 }
 
 type VPCSecurityGroup struct {
-	Id           string                   `json:"id"`
-	FolderId     string                   `json:"folderId"`
-	NetworkId    string                   `json:"networkId"`
-	Name         string                   `json:"name"`
-	Description  string                   `json:"description"`
-	Labels       map[string]string        `json:"labels"`
-	CreatedAt    string                   `json:"createdAt"`
-	Rules        []map[string]interface{} `json:"rules"`
-	IngressRules []map[string]interface{} `json:"ingressRules"`
-	EgressRules  []map[string]interface{} `json:"egressRules"`
-}
+	Id                string              `json:"id"`
+	FolderId          string              `json:"folderId"`
+	NetworkId         string              `json:"networkId"`
+	Name              string              `json:"name"`
+	Description       string              `json:"description"`
+	Labels            map[string]string   `json:"labels"`
+	CreatedAt         string              `json:"createdAt"`
+	Status            string              `json:"status"`
+	DefaultForNetwork bool                `json:"defaultForNetwork"`
+	Rules             []SecurityGroupRule `json:"rules"`
+}
+
+// SecurityGroupRule is a single rule of a security group.
+// The API returns ingress and egress rules in one list, distinguished by Direction.
+type SecurityGroupRule struct {
+	Id               string            `json:"id"`
+	Description      string            `json:"description"`
+	Labels           map[string]string `json:"labels"`
+	Direction        string            `json:"direction"`
+	Ports            *PortRange        `json:"ports,omitempty"`
+	ProtocolName     string            `json:"protocolName"`
+	ProtocolNumber   string            `json:"protocolNumber"`
+	CidrBlocks       *CidrBlocks       `json:"cidrBlocks,omitempty"`
+	SecurityGroupId  string            `json:"securityGroupId,omitempty"`
+	PredefinedTarget string            `json:"predefinedTarget,omitempty"`
+}
+
+type PortRange struct {
+	FromPort int64 `json:"fromPort,string"`
+	ToPort   int64 `json:"toPort,string"`
+}
+
+type CidrBlocks struct {
+	V4CidrBlocks []string `json:"v4CidrBlocks"`
+	V6CidrBlocks []string `json:"v6CidrBlocks"`
+}
+
+const (
+	SecurityGroupRuleDirectionIngress = "INGRESS"
+	SecurityGroupRuleDirectionEgress  = "EGRESS"
+)
 
 type VPCSecurityGroupID string
 
@@ -167,6 +237,36 @@ type GetVPCGatewayResponse struct {
 	Gateway *VPCGateway `json:"gateway"`
 }
 
+// --- VPC Private Endpoint types ---
+type VPCPrivateEndpoint struct {
+	Id            string                  `json:"id"`
+	FolderId      string                  `json:"folderId"`
+	CreatedAt     string                  `json:"createdAt"`
+	Name          string                  `json:"name"`
+	Description   string                  `json:"description"`
+	Labels        map[string]string       `json:"labels"`
+	NetworkId     string                  `json:"networkId"`
+	Status        string                  `json:"status"`
+	Address       *PrivateEndpointAddress `json:"address,omitempty"`
+	DnsOptions    *PrivateEndpointDns     `json:"dnsOptions,omitempty"`
+	ObjectStorage map[string]interface{}  `json:"objectStorage,omitempty"`
+}
+
+type PrivateEndpointAddress struct {
+	SubnetId  string `json:"subnetId"`
+	Address   string `json:"address"`
+	AddressId string `json:"addressId"`
+}
+
+type PrivateEndpointDns struct {
+	PrivateDnsRecordsEnabled bool `json:"privateDnsRecordsEnabled"`
+}
+
+type ListVPCPrivateEndpointsResponse struct {
+	PrivateEndpoints []*VPCPrivateEndpoint `json:"privateEndpoints"`
+	NextPageToken    string                `json:"nextPageToken"`
+}
+
 // --- VPC Operation types ---
 type VPCOperation struct {
 	Id          string                 `json:"id"`
@@ -196,6 +296,7 @@ type VPCClient interface {
 	GetVPCNetwork(ctx context.Context, networkID VPCNetworkID) (*VPCNetwork, error)
 	ListVPCSubnets(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*VPCSubnet, string, error)
 	GetVPCSubnet(ctx context.Context, subnetID VPCSubnetID) (*VPCSubnet, error)
+	ListVPCSubnetUsedAddresses(ctx context.Context, subnetID VPCSubnetID, pageToken string, pageSize int64) ([]*VPCUsedAddress, string, error)
 	ListVPCRouteTables(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*VPCRouteTable, string, error)
 	GetVPCRouteTable(ctx context.Context, routeTableID VPCRouteTableID) (*VPCRouteTable, error)
 	ListVPCSecurityGroups(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*VPCSecurityGroup, string, error)
@@ -204,6 +305,10 @@ type VPCClient interface {
 	GetVPCAddress(ctx context.Context, addressID VPCAddressID) (*VPCAddress, error)
 	ListVPCGateways(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*VPCGateway, string, error)
 	GetVPCGateway(ctx context.Context, gatewayID VPCGatewayID) (*VPCGateway, error)
+	ListVPCPrivateEndpoints(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*VPCPrivateEndpoint, string, error)
+	ListVPCNetworkSubnets(ctx context.Context, networkID VPCNetworkID, pageToken string, pageSize int64) ([]*VPCSubnet, string, error)
+	ListVPCNetworkSecurityGroups(ctx context.Context, networkID VPCNetworkID, pageToken string, pageSize int64) ([]*VPCSecurityGroup, string, error)
+	ListVPCNetworkRouteTables(ctx context.Context, networkID VPCNetworkID, pageToken string, pageSize int64) ([]*VPCRouteTable, string, error)
 	ListVPCOperations(ctx context.Context, pageToken string, pageSize int64) ([]*VPCOperation, string, error)
 	GetVPCOperation(ctx context.Context, operationID VPCOperationID) (*VPCOperation, error)
 }
@@ -305,6 +410,24 @@ func (c *yandexVPCClient) GetVPCSubnet(ctx context.Context, subnetID VPCSubnetID
 	return respBody.Subnet, nil
 }
 
+func (c *yandexVPCClient) ListVPCSubnetUsedAddresses(ctx context.Context, subnetID VPCSubnetID, pageToken string, pageSize int64) ([]*VPCUsedAddress, string, error) {
+	endpoint := fmt.Sprintf("https://vpc.api.cloud.yandex.net/vpc/v1/subnets/%s/addresses", subnetID)
+	params := url.Values{}
+	if pageToken != "" {
+		params.Set("pageToken", pageToken)
+	}
+	if pageSize > 0 {
+		params.Set("pageSize", strconv.FormatInt(pageSize, 10))
+	}
+	var respBody ListVPCSubnetUsedAddressesResponse
+	urlStr := fmt.Sprintf("%s?%s", endpoint, params.Encode())
+	err := c.apiGet(ctx, urlStr, &respBody)
+	if err != nil {
+		return nil, "", err
+	}
+	return respBody.Addresses, respBody.NextPageToken, nil
+}
+
 func (c *yandexVPCClient) ListVPCRouteTables(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*VPCRouteTable, string, error) {
 	const endpoint = "https://vpc.api.cloud.yandex.net/vpc/v1/routeTables"
 	params := url.Values{}
@@ -417,6 +540,62 @@ func (c *yandexVPCClient) GetVPCGateway(ctx context.Context, gatewayID VPCGatewa
 	return respBody.Gateway, nil
 }
 
+func (c *yandexVPCClient) ListVPCPrivateEndpoints(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*VPCPrivateEndpoint, string, error) {
+	const endpoint = "https://vpc.api.cloud.yandex.net/vpc/v1/endpoints"
+	params := url.Values{}
+	params.Set("folderId", folderID)
+	if pageToken != "" {
+		params.Set("pageToken", pageToken)
+	}
+	if pageSize > 0 {
+		params.Set("pageSize", strconv.FormatInt(pageSize, 10))
+	}
+	var respBody ListVPCPrivateEndpointsResponse
+	urlStr := fmt.Sprintf("%s?%s", endpoint, params.Encode())
+	err := c.apiGet(ctx, urlStr, &respBody)
+	if err != nil {
+		return nil, "", err
+	}
+	return respBody.PrivateEndpoints, respBody.NextPageToken, nil
+}
+
+// networkChildURL builds the URL of a network-scoped list method, e.g. /networks/{id}/subnets.
+func networkChildURL(networkID VPCNetworkID, child string, pageToken string, pageSize int64) string {
+	endpoint := fmt.Sprintf("https://vpc.api.cloud.yandex.net/vpc/v1/networks/%s/%s", networkID, child)
+	params := url.Values{}
+	if pageToken != "" {
+		params.Set("pageToken", pageToken)
+	}
+	if pageSize > 0 {
+		params.Set("pageSize", strconv.FormatInt(pageSize, 10))
+	}
+	return fmt.Sprintf("%s?%s", endpoint, params.Encode())
+}
+
+func (c *yandexVPCClient) ListVPCNetworkSubnets(ctx context.Context, networkID VPCNetworkID, pageToken string, pageSize int64) ([]*VPCSubnet, string, error) {
+	var respBody ListVPCSubnetsResponse
+	if err := c.apiGet(ctx, networkChildURL(networkID, "subnets", pageToken, pageSize), &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.Subnets, respBody.NextPageToken, nil
+}
+
+func (c *yandexVPCClient) ListVPCNetworkSecurityGroups(ctx context.Context, networkID VPCNetworkID, pageToken string, pageSize int64) ([]*VPCSecurityGroup, string, error) {
+	var respBody ListVPCSecurityGroupsResponse
+	if err := c.apiGet(ctx, networkChildURL(networkID, "securityGroups", pageToken, pageSize), &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.SecurityGroups, respBody.NextPageToken, nil
+}
+
+func (c *yandexVPCClient) ListVPCNetworkRouteTables(ctx context.Context, networkID VPCNetworkID, pageToken string, pageSize int64) ([]*VPCRouteTable, string, error) {
+	var respBody ListVPCRouteTablesResponse
+	if err := c.apiGet(ctx, networkChildURL(networkID, "routeTables", pageToken, pageSize), &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.RouteTables, respBody.NextPageToken, nil
+}
+
 func (c *yandexVPCClient) ListVPCOperations(ctx context.Context, pageToken string, pageSize int64) ([]*VPCOperation, string, error) {
 	const endpoint = "https://operation.api.cloud.yandex.net/operations"
 	params := url.Values{}
@@ -0,0 +1,83 @@
+package yandexcloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// --- YDB types ---
+type YDBDatabase struct {
+	Id                  string                 `json:"id"`
+	FolderId            string                 `json:"folderId"`
+	CreatedAt           string                 `json:"createdAt"`
+	Name                string                 `json:"name"`
+	Description         string                 `json:"description"`
+	Labels              map[string]string      `json:"labels"`
+	Status              string                 `json:"status"`
+	Endpoint            string                 `json:"endpoint"`
+	DocumentApiEndpoint string                 `json:"documentApiEndpoint"`
+	KinesisApiEndpoint  string                 `json:"kinesisApiEndpoint"`
+	ResourcePresetId    string                 `json:"resourcePresetId"`
+	StorageConfig       map[string]interface{} `json:"storageConfig"`
+	ScalePolicy         map[string]interface{} `json:"scalePolicy"`
+	NetworkId           string                 `json:"networkId"`
+	SubnetIds           []string               `json:"subnetIds"`
+	AssignPublicIps     bool                   `json:"assignPublicIps"`
+	LocationId          string                 `json:"locationId"`
+	BackupConfig        map[string]interface{} `json:"backupConfig"`
+	MonitoringConfig    map[string]interface{} `json:"monitoringConfig"`
+	DeletionProtection  bool                   `json:"deletionProtection"`
+	SecurityGroupIds    []string               `json:"securityGroupIds"`
+	ServerlessDatabase  map[string]interface{} `json:"serverlessDatabase,omitempty"`
+	DedicatedDatabase   map[string]interface{} `json:"dedicatedDatabase,omitempty"`
+	ZonalDatabase       map[string]interface{} `json:"zonalDatabase,omitempty"`
+	RegionalDatabase    map[string]interface{} `json:"regionalDatabase,omitempty"`
+}
+
+type ListYDBDatabasesResponse struct {
+	Databases     []*YDBDatabase `json:"databases"`
+	NextPageToken string         `json:"nextPageToken"`
+}
+
+type YDBClient interface {
+	ListYDBDatabases(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*YDBDatabase, string, error)
+}
+
+type yandexYDBClient struct {
+	token  string
+	http   *http.Client
+	config *Config
+}
+
+func NewYDBClient(token string, timeoutSec int64, config *Config) YDBClient {
+	return &yandexYDBClient{
+		token:  token,
+		http:   GetHTTPClient(timeoutSec),
+		config: config,
+	}
+}
+
+func (c *yandexYDBClient) apiGet(ctx context.Context, urlStr string, out interface{}) error {
+	return restGet(ctx, c.http, c.config, c.token, "YDB", urlStr, out)
+}
+
+func (c *yandexYDBClient) ListYDBDatabases(ctx context.Context, folderID string, pageToken string, pageSize int64) ([]*YDBDatabase, string, error) {
+	const endpoint = "https://ydb.api.cloud.yandex.net/ydb/v1/databases"
+	params := url.Values{}
+	params.Set("folderId", folderID)
+	if pageToken != "" {
+		params.Set("pageToken", pageToken)
+	}
+	if pageSize > 0 {
+		params.Set("pageSize", strconv.FormatInt(pageSize, 10))
+	}
+	var respBody ListYDBDatabasesResponse
+	urlStr := fmt.Sprintf("%s?%s", endpoint, params.Encode())
+	if err := c.apiGet(ctx, urlStr, &respBody); err != nil {
+		return nil, "", err
+	}
+	return respBody.Databases, respBody.NextPageToken, nil
+}
@@ -0,0 +1,139 @@
+package yandexcloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// defaultYMQEndpoint is the SQS-compatible endpoint of Yandex Message Queue.
+const defaultYMQEndpoint = "https://message-queue.api.cloud.yandex.net"
+
+type ymqListQueuesResponse struct {
+	QueueUrls []string `xml:"ListQueuesResult>QueueUrl"`
+	NextToken string   `xml:"ListQueuesResult>NextToken"`
+}
+
+type ymqGetQueueAttributesResponse struct {
+	Attributes []struct {
+		Name  string `xml:"Name"`
+		Value string `xml:"Value"`
+	} `xml:"GetQueueAttributesResult>Attribute"`
+}
+
+// YMQClient calls the SQS-compatible Message Queue API, which is authenticated with a static access key.
+type YMQClient interface {
+	ListYMQQueues(ctx context.Context, namePrefix string) ([]string, error)
+	GetYMQQueueAttributes(ctx context.Context, queueURL string) (map[string]string, error)
+}
+
+type yandexYMQClient struct {
+	creds    *StaticKeyCredentials
+	endpoint string
+	http     *http.Client
+	config   *Config
+}
+
+func NewYMQClient(timeoutSec int64, config *Config) (YMQClient, error) {
+	creds, err := getStaticKeyCredentials(config)
+	if err != nil {
+		return nil, err
+	}
+	endpoint := defaultYMQEndpoint
+	if config.YMQEndpoint != nil && *config.YMQEndpoint != "" {
+		endpoint = strings.TrimSuffix(string(*config.YMQEndpoint), "/")
+	}
+	return &yandexYMQClient{
+		creds:    creds,
+		endpoint: endpoint,
+		http:     GetHTTPClient(timeoutSec),
+		config:   config,
+	}, nil
+}
+
+// apiCall sends a signed Query API action and decodes the XML response.
+func (c *yandexYMQClient) apiCall(ctx context.Context, params url.Values, out interface{}) error {
+	params.Set("Version", "2012-11-05")
+	body := []byte(params.Encode())
+	LogInfo(ctx, "YMQ apiCall: %s", params.Get("Action"))
+	retryCount := 3
+	if c.config != nil && c.config.Retry != nil && *c.config.Retry > 0 {
+		retryCount = *c.config.Retry
+	}
+	reqFactory := func() *http.Request {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint+"/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		if c.config != nil && c.config.UserAgent != nil {
+			ua := string(*c.config.UserAgent)
+			ApplyRequestOptions(req, &ua, nil)
+		}
+		signRequestV4(req, body, c.creds, staticKeyRegion, "sqs", time.Now())
+		return req
+	}
+	resp, err := DoWithRetry(ctx, c.http, reqFactory, retryCount, int64(c.http.Timeout.Seconds()))
+	if err != nil {
+		LogError(ctx, "YMQ request failed: %v", err)
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(resp.Body)
+	resp.Body = io.NopCloser(bytes.NewBuffer(respBody))
+	if err := HandleHTTPError(resp); err != nil {
+		LogError(ctx, "YMQ HTTP error: %v", err)
+		return err
+	}
+	if err := xml.Unmarshal(respBody, out); err != nil {
+		LogError(ctx, "YMQ apiCall: failed to decode response: %v", err)
+		return err
+	}
+	return nil
+}
+
+// ListYMQQueues returns the URLs of all queues with the given name prefix, following NextToken
+// since a single ListQueues call returns at most 1000 queues.
+func (c *yandexYMQClient) ListYMQQueues(ctx context.Context, namePrefix string) ([]string, error) {
+	var queueURLs []string
+	nextToken := ""
+	for {
+		params := url.Values{"Action": {"ListQueues"}, "MaxResults": {"1000"}}
+		if namePrefix != "" {
+			params.Set("QueueNamePrefix", namePrefix)
+		}
+		if nextToken != "" {
+			params.Set("NextToken", nextToken)
+		}
+		var respBody ymqListQueuesResponse
+		if err := c.apiCall(ctx, params, &respBody); err != nil {
+			return nil, err
+		}
+		queueURLs = append(queueURLs, respBody.QueueUrls...)
+		if respBody.NextToken == "" {
+			break
+		}
+		nextToken = respBody.NextToken
+	}
+	return queueURLs, nil
+}
+
+// GetYMQQueueAttributes returns all attributes of the queue with the given URL.
+func (c *yandexYMQClient) GetYMQQueueAttributes(ctx context.Context, queueURL string) (map[string]string, error) {
+	params := url.Values{
+		"Action":          {"GetQueueAttributes"},
+		"QueueUrl":        {queueURL},
+		"AttributeName.1": {"All"},
+	}
+	var respBody ymqGetQueueAttributesResponse
+	if err := c.apiCall(ctx, params, &respBody); err != nil {
+		return nil, err
+	}
+	attributes := make(map[string]string, len(respBody.Attributes))
+	for _, a := range respBody.Attributes {
+		attributes[a.Name] = a.Value
+	}
+	return attributes, nil
+}
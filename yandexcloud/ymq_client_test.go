@@ -0,0 +1,99 @@
+package yandexcloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/context_key"
+)
+
+// newSQSStandIn returns a minimal SQS-compatible server that checks the request signature header.
+func newSQSStandIn(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKID/") || !strings.Contains(auth, "/ru-central1/sqs/aws4_request") {
+			t.Errorf("unexpected Authorization header: %s", auth)
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		switch r.PostForm.Get("Action") {
+		case "ListQueues":
+			if r.PostForm.Get("MaxResults") != "1000" {
+				t.Errorf("unexpected MaxResults: %q", r.PostForm.Get("MaxResults"))
+			}
+			// The queues are split over two pages to exercise NextToken.
+			if r.PostForm.Get("NextToken") == "" {
+				w.Write([]byte(`<ListQueuesResponse><ListQueuesResult>
+				<QueueUrl>https://message-queue.api.cloud.yandex.net/b1g1/dj6/orders</QueueUrl>
+				<NextToken>page2</NextToken>
+			</ListQueuesResult></ListQueuesResponse>`))
+				return
+			}
+			w.Write([]byte(`<ListQueuesResponse><ListQueuesResult>
+				<QueueUrl>https://message-queue.api.cloud.yandex.net/b1g1/dj7/orders-dlq</QueueUrl>
+			</ListQueuesResult></ListQueuesResponse>`))
+		case "GetQueueAttributes":
+			if !strings.HasSuffix(r.PostForm.Get("QueueUrl"), "/orders") {
+				w.Write([]byte(`<GetQueueAttributesResponse><GetQueueAttributesResult/></GetQueueAttributesResponse>`))
+				return
+			}
+			w.Write([]byte(`<GetQueueAttributesResponse><GetQueueAttributesResult>
+				<Attribute><Name>QueueArn</Name><Value>yrn:yc:ymq:ru-central1:b1gfolder:orders</Value></Attribute>
+				<Attribute><Name>VisibilityTimeout</Name><Value>30</Value></Attribute>
+				<Attribute><Name>MessageRetentionPeriod</Name><Value>345600</Value></Attribute>
+				<Attribute><Name>ApproximateNumberOfMessages</Name><Value>12</Value></Attribute>
+				<Attribute><Name>CreatedTimestamp</Name><Value>1700000000</Value></Attribute>
+				<Attribute><Name>LastModifiedTimestamp</Name><Value>1710000000</Value></Attribute>
+				<Attribute><Name>RedrivePolicy</Name><Value>{"deadLetterTargetArn":"yrn:yc:ymq:ru-central1:b1gfolder:orders-dlq","maxReceiveCount":5}</Value></Attribute>
+			</GetQueueAttributesResult></GetQueueAttributesResponse>`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+}
+
+func TestYMQClient(t *testing.T) {
+	ts := newSQSStandIn(t)
+	defer ts.Close()
+
+	key, secret, endpoint := AccessKeyID("AKID"), SecretAccessKey("SECRET"), YMQEndpoint(ts.URL)
+	client, err := NewYMQClient(5, &Config{AccessKeyID: &key, SecretAccessKey: &secret, YMQEndpoint: &endpoint})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ctx := context.WithValue(context.Background(), context_key.Logger, hclog.NewNullLogger())
+	urls, err := client.ListYMQQueues(ctx, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(urls) != 2 {
+		t.Fatalf("expected 2 queues, got %v", urls)
+	}
+	attributes, err := client.GetYMQQueueAttributes(ctx, urls[0])
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	row := ymqQueueRow(urls[0], attributes)
+	if row.Name != "orders" || row.FolderId != "b1gfolder" || *row.VisibilityTimeout != 30 || *row.ApproximateNumberOfMessages != 12 {
+		t.Errorf("unexpected queue row: %+v", row)
+	}
+	if row.DeadLetterTargetArn != "yrn:yc:ymq:ru-central1:b1gfolder:orders-dlq" || row.MaxReceiveCount == nil || *row.MaxReceiveCount != 5 {
+		t.Errorf("unexpected redrive policy: %+v", row.RedrivePolicy)
+	}
+	if row.CreatedAt != "2023-11-14" || row.LastModifiedAt != "2024-03-09" || row.DelaySeconds != nil {
+		t.Errorf("unexpected dates or delay: %+v", row)
+	}
+}
+
+func TestNewYMQClient_NoKeys(t *testing.T) {
+	if _, err := NewYMQClient(5, &Config{}); err == nil {
+		t.Error("expected error without a static access key")
+	}
+}